@@ -99,8 +99,8 @@ func TestAADatabase_Create(t *testing.T) {
 		SupportOSSClusterAPI:                redis.Bool(false),
 		RespVersion:                         redis.String("resp3"),
 		UseExternalEndpointForOSSClusterAPI: redis.Bool(false),
-		DataEvictionPolicy:                  redis.String("noeviction"),
-		GlobalDataPersistence:               redis.String("none"),
+		DataEvictionPolicy:                  redis.Ptr(databases.EvictionPolicyNoEviction),
+		GlobalDataPersistence:               redis.Ptr(databases.DataPersistenceNone),
 		GlobalSourceIP:                      redis.StringSlice("0.0.0.0/0"),
 		GlobalPassword:                      redis.String("test-password"),
 		GlobalAlerts: []*databases.Alert{
@@ -213,18 +213,18 @@ func TestAADatabase_Update(t *testing.T) {
 		SupportOSSClusterAPI:                redis.Bool(false),
 		UseExternalEndpointForOSSClusterAPI: redis.Bool(false),
 		ClientSSLCertificate:                redis.String("cert-content"),
-		ClientTLSCertificates:               &[]*string{redis.String("cert1"), redis.String("cert2")},
+		ClientTLSCertificates:               redis.Set([]*string{redis.String("cert1"), redis.String("cert2")}),
 		EnableTls:                           redis.Bool(true),
-		GlobalDataPersistence:               redis.String("aof-every-1-second"),
+		GlobalDataPersistence:               redis.Ptr(databases.DataPersistenceAOFEvery1Second),
 		GlobalPassword:                      redis.String("new-password"),
 		GlobalEnableDefaultUser:             redis.Bool(true),
 		GlobalSourceIP:                      redis.StringSlice("192.168.1.0/24"),
-		GlobalAlerts: &[]*databases.Alert{
+		GlobalAlerts: redis.Set([]*databases.Alert{
 			{
 				Name:  redis.String("throughput-higher-than"),
 				Value: redis.Int(90),
 			},
-		},
+		}),
 		Regions: []*databases.LocalRegionProperties{
 			{
 				Region: redis.String("us-east-1"),
@@ -239,19 +239,19 @@ func TestAADatabase_Update(t *testing.T) {
 					WriteOperationsPerSecond: redis.Int(2000),
 					ReadOperationsPerSecond:  redis.Int(2000),
 				},
-				DataPersistence:   redis.String("aof-every-1-second"),
+				DataPersistence:   redis.Ptr(databases.DataPersistenceAOFEvery1Second),
 				Password:          redis.String("region-password"),
 				SourceIP:          redis.StringSlice("10.0.0.0/8"),
 				EnableDefaultUser: redis.Bool(false),
-				Alerts: &[]*databases.Alert{
+				Alerts: redis.Set([]*databases.Alert{
 					{
 						Name:  redis.String("dataset-size"),
 						Value: redis.Int(85),
 					},
-				},
+				}),
 			},
 		},
-		DataEvictionPolicy:      redis.String("allkeys-lru"),
+		DataEvictionPolicy:      redis.Ptr(databases.EvictionPolicyAllKeysLRU),
 		QueryPerformanceFactor:  redis.String("6x"),
 		AutoMinorVersionUpgrade: redis.Bool(true),
 	})
@@ -379,7 +379,7 @@ func TestAADatabase_Get(t *testing.T) {
 		Name:                                redis.String("active-active-db"),
 		Protocol:                            redis.String("redis"),
 		RedisVersion:                        redis.String("7.2"),
-		Status:                              redis.String("active"),
+		Status:                              redis.Ptr(databases.StatusActive),
 		MemoryStorage:                       redis.String("ram"),
 		ActiveActiveRedis:                   redis.Bool(true),
 		ActivatedOn:                         redis.Time(time.Date(2024, 5, 8, 8, 10, 02, 0, time.UTC)),
@@ -387,10 +387,10 @@ func TestAADatabase_Get(t *testing.T) {
 		SupportOSSClusterAPI:                redis.Bool(false),
 		UseExternalEndpointForOSSClusterAPI: redis.Bool(false),
 		Replication:                         redis.Bool(true),
-		DataEvictionPolicy:                  redis.String("noeviction"),
+		DataEvictionPolicy:                  redis.Ptr(databases.EvictionPolicyNoEviction),
 		AutoMinorVersionUpgrade:             redis.Bool(true),
 		Modules:                             []*databases.Module{},
-		GlobalDataPersistence:               redis.String("aof-every-1-second"),
+		GlobalDataPersistence:               redis.Ptr(databases.DataPersistenceAOFEvery1Second),
 		GlobalSourceIP:                      redis.StringSlice("192.168.1.0/24"),
 		GlobalPassword:                      redis.String("********"),
 		GlobalAlerts: []*databases.Alert{
@@ -412,7 +412,7 @@ func TestAADatabase_Get(t *testing.T) {
 				MemoryUsedInMB:           redis.Float64(45.5),
 				ReadOperationsPerSecond:  redis.Int(2000),
 				WriteOperationsPerSecond: redis.Int(2000),
-				DataPersistence:          redis.String("aof-every-1-second"),
+				DataPersistence:          redis.Ptr(databases.DataPersistenceAOFEvery1Second),
 				QueryPerformanceFactor:   redis.String("6x"),
 				Alerts: []*databases.Alert{
 					{
@@ -445,7 +445,7 @@ func TestAADatabase_Get(t *testing.T) {
 				MemoryUsedInMB:           redis.Float64(45.3),
 				ReadOperationsPerSecond:  redis.Int(2000),
 				WriteOperationsPerSecond: redis.Int(2000),
-				DataPersistence:          redis.String("aof-every-1-second"),
+				DataPersistence:          redis.Ptr(databases.DataPersistenceAOFEvery1Second),
 				QueryPerformanceFactor:   redis.String("6x"),
 				Alerts: []*databases.Alert{
 					{
@@ -638,7 +638,7 @@ func TestAADatabase_List(t *testing.T) {
 			Name:                                redis.String("creation-plan-db-1"),
 			Protocol:                            redis.String("redis"),
 			RedisVersion:                        redis.String("7.2"),
-			Status:                              redis.String("active"),
+			Status:                              redis.Ptr(databases.StatusActive),
 			MemoryStorage:                       redis.String("ram"),
 			ActiveActiveRedis:                   redis.Bool(true),
 			ActivatedOn:                         redis.Time(time.Date(2024, 5, 8, 8, 10, 02, 0, time.UTC)),
@@ -646,10 +646,10 @@ func TestAADatabase_List(t *testing.T) {
 			SupportOSSClusterAPI:                redis.Bool(false),
 			UseExternalEndpointForOSSClusterAPI: redis.Bool(false),
 			Replication:                         redis.Bool(true),
-			DataEvictionPolicy:                  redis.String("noeviction"),
+			DataEvictionPolicy:                  redis.Ptr(databases.EvictionPolicyNoEviction),
 			AutoMinorVersionUpgrade:             redis.Bool(true),
 			Modules:                             []*databases.Module{},
-			GlobalDataPersistence:               redis.String("none"),
+			GlobalDataPersistence:               redis.Ptr(databases.DataPersistenceNone),
 			GlobalSourceIP:                      redis.StringSlice("0.0.0.0/0"),
 			GlobalAlerts: []*databases.Alert{
 				{
@@ -670,7 +670,7 @@ func TestAADatabase_List(t *testing.T) {
 					MemoryUsedInMB:           redis.Float64(29.9949),
 					ReadOperationsPerSecond:  redis.Int(1000),
 					WriteOperationsPerSecond: redis.Int(1000),
-					DataPersistence:          redis.String("none"),
+					DataPersistence:          redis.Ptr(databases.DataPersistenceNone),
 					QueryPerformanceFactor:   redis.String("Standard"),
 					Alerts: []*databases.Alert{
 						{
@@ -702,7 +702,7 @@ func TestAADatabase_List(t *testing.T) {
 					MemoryUsedInMB:           redis.Float64(29.9788),
 					ReadOperationsPerSecond:  redis.Int(1000),
 					WriteOperationsPerSecond: redis.Int(1000),
-					DataPersistence:          redis.String("none"),
+					DataPersistence:          redis.Ptr(databases.DataPersistenceNone),
 					QueryPerformanceFactor:   redis.String("Standard"),
 					Alerts: []*databases.Alert{
 						{
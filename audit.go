@@ -0,0 +1,93 @@
+package rediscloud_api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+)
+
+// AuditEvent records a request changing resources: its operation, path, redacted body, task, outcome, duration and
+// caller.
+type AuditEvent = internal.AuditEvent
+
+// AuditSink receives an AuditEvent for every create, update and delete made through the client.
+type AuditSink = internal.AuditSink
+
+const (
+	// AuditSucceeded is the Status of events whose request, and task, succeeded.
+	AuditSucceeded = internal.AuditSucceeded
+	// AuditFailed is the Status of events whose request, or task, failed.
+	AuditFailed = internal.AuditFailed
+	// AuditAccepted is the Status of events whose task was left processing with NoWait.
+	AuditAccepted = internal.AuditAccepted
+)
+
+// Audit records every create, update and delete made through the client in an AuditSink, such as an AuditFile -
+// will default to recording nothing. Events are recorded once the API has processed the request, including its task,
+// and hold the request body with its secrets redacted as with LogRequests.
+func Audit(sink AuditSink) Option {
+	return func(options *Options) {
+		options.audit = sink
+	}
+}
+
+// WithCaller sets the identity recorded as the caller of the requests made with the returned context, e.g. the user
+// of a service acting on their behalf.
+func WithCaller(ctx context.Context, caller string) context.Context {
+	return internal.WithCaller(ctx, caller)
+}
+
+// AuditFile is an AuditSink writing events as JSON lines, one object per event.
+type AuditFile struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewAuditFile returns an AuditSink writing JSON lines to w.
+func NewAuditFile(w io.Writer) *AuditFile {
+	return &AuditFile{w: w}
+}
+
+// OpenAuditFile returns an AuditSink appending JSON lines to the file at path, which is created if needed. Each event
+// is synced to disk before the request returns.
+func OpenAuditFile(path string) (*AuditFile, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600) //nolint:gosec // G304: path from SDK caller
+	if err != nil {
+		return nil, err
+	}
+	return &AuditFile{w: file}, nil
+}
+
+func (a *AuditFile) Record(_ context.Context, event AuditEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.w.Write(line); err != nil {
+		return err
+	}
+	if file, ok := a.w.(*os.File); ok {
+		return file.Sync()
+	}
+	return nil
+}
+
+// Close closes the file of an AuditFile opened with OpenAuditFile, or the io.Closer given to NewAuditFile.
+func (a *AuditFile) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if closer, ok := a.w.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+var _ AuditSink = &AuditFile{}
@@ -0,0 +1,152 @@
+package rediscloud_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func auditedClient(t *testing.T, s *httptest.Server, sink AuditSink) *Client {
+	subject, err := NewClient(BaseURL(s.URL+"/v1"), Auth("key", "secret"), Transporter(s.Client().Transport), Audit(sink))
+	require.NoError(t, err)
+	return subject
+}
+
+func readAudit(t *testing.T, data []byte) []AuditEvent {
+	var events []AuditEvent
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if line == "" {
+			continue
+		}
+		var event AuditEvent
+		require.NoError(t, json.Unmarshal([]byte(line), &event))
+		assert.NotZero(t, event.Time)
+		assert.Positive(t, event.Duration)
+		event.Time, event.Duration = event.Time.AddDate(-event.Time.Year()+1, 0, 0).Truncate(0), 0
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestAudit_recordsCreateOnceTaskIsProcessed(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		taskFlow(t, http.MethodPost, "/acl/users", `{"name": "user", "role": "role", "password": "hunter2"}`, "task-id", "aclUserCreateRequest")...))
+	defer s.Close()
+
+	var out bytes.Buffer
+	subject := auditedClient(t, s, NewAuditFile(&out))
+
+	ctx := WithCaller(context.TODO(), "alice@example.org")
+	_, err := subject.Users.Create(ctx, users.CreateUserRequest{
+		Name:     redis.String("user"),
+		Role:     redis.String("role"),
+		Password: redis.String("hunter2"),
+	})
+	require.NoError(t, err)
+
+	events := readAudit(t, out.Bytes())
+	require.Len(t, events, 1)
+	assert.Equal(t, AuditEvent{
+		Time:       events[0].Time,
+		Operation:  "create user",
+		Method:     http.MethodPost,
+		Path:       "/acl/users",
+		Request:    json.RawMessage(`{"name":"user","role":"role","password":"REDACTED"}`),
+		TaskID:     "task-id",
+		Status:     AuditSucceeded,
+		HTTPStatus: http.StatusOK,
+		Caller:     "alice@example.org",
+	}, events[0])
+}
+
+func TestAudit_recordsFailedTask(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		postRequest(t, "/acl/users", `{"name": "user", "role": "missing", "password": "hunter2"}`, `{"taskId": "task-id", "status": "received"}`),
+		getRequest(t, "/tasks/task-id", `{
+		  "taskId": "task-id",
+		  "status": "processing-error",
+		  "response": {"error": {"type": "ACL_ROLE_DOES_NOT_EXISTS", "status": "400 BAD_REQUEST", "description": "ACL role associated with a user has to exist."}}
+		}`),
+	))
+	defer s.Close()
+
+	var out bytes.Buffer
+	subject := auditedClient(t, s, NewAuditFile(&out))
+
+	_, err := subject.Users.Create(context.TODO(), users.CreateUserRequest{
+		Name:     redis.String("user"),
+		Role:     redis.String("missing"),
+		Password: redis.String("hunter2"),
+	})
+	require.Error(t, err)
+
+	events := readAudit(t, out.Bytes())
+	require.Len(t, events, 1)
+	assert.Equal(t, "task-id", events[0].TaskID)
+	assert.Equal(t, AuditFailed, events[0].Status)
+	assert.Contains(t, events[0].Error, "ACL role associated with a user has to exist.")
+	assert.Empty(t, events[0].Caller)
+}
+
+func TestAudit_recordsRefusedRequest(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		deleteRequestWithStatus(t, "/acl/users/12", http.StatusNotFound, `{"error": "not found"}`)))
+	defer s.Close()
+
+	var out bytes.Buffer
+	subject := auditedClient(t, s, NewAuditFile(&out))
+
+	require.Error(t, subject.Users.Delete(context.TODO(), 12))
+
+	events := readAudit(t, out.Bytes())
+	require.Len(t, events, 1)
+	assert.Equal(t, "delete user 12", events[0].Operation)
+	assert.Equal(t, http.MethodDelete, events[0].Method)
+	assert.Equal(t, "/acl/users/12", events[0].Path)
+	assert.Empty(t, events[0].TaskID)
+	assert.Equal(t, AuditFailed, events[0].Status)
+	assert.Equal(t, http.StatusNotFound, events[0].HTTPStatus)
+}
+
+func TestAudit_ignoresReads(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret", getRequest(t, "/subscriptions", `{"subscriptions": []}`)))
+	defer s.Close()
+
+	var out bytes.Buffer
+	subject := auditedClient(t, s, NewAuditFile(&out))
+
+	_, err := subject.Subscription.List(context.TODO())
+	require.NoError(t, err)
+	assert.Empty(t, out.String())
+}
+
+func TestOpenAuditFile_appends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	for _, operation := range []string{"first", "second"} {
+		sink, err := OpenAuditFile(path)
+		require.NoError(t, err)
+		require.NoError(t, sink.Record(context.TODO(), AuditEvent{Operation: operation, Status: AuditSucceeded}))
+		require.NoError(t, sink.Close())
+	}
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, `{"time":"0001-01-01T00:00:00Z","operation":"first","method":"","path":"","status":"succeeded","duration":0}
+{"time":"0001-01-01T00:00:00Z","operation":"second","method":"","path":"","status":"succeeded","duration":0}
+`, string(data))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
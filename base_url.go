@@ -0,0 +1,97 @@
+package rediscloud_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// apiVersionPath is the path every base URL of the API ends with.
+const apiVersionPath = "/v1"
+
+// DialFunc connects to the API, as http.Transport's DialContext.
+type DialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// Dialer sets how connections to the API are made, e.g. to go through a local proxy - will default to the dialer of
+// the transport. It needs the transport, if set with Transporter, to be an *http.Transport.
+func Dialer(dial DialFunc) Option {
+	return func(options *Options) {
+		options.dialer = dial
+	}
+}
+
+// baseURL is a checked base URL of the API.
+type baseURL struct {
+	// url is what the paths of requests are appended to
+	url *url.URL
+	// socket is the path of the unix socket requests are sent to, if any
+	socket string
+}
+
+// parseBaseURL checks a base URL of the API, which is either `http(s)://host[:port][/prefix]/v1` or
+// `unix:///path/to/socket`, whose requests are sent to `/v1` through the socket.
+func parseBaseURL(raw string) (*baseURL, error) {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL %q: %w", raw, err)
+	}
+	if parsed.RawQuery != "" || parsed.Fragment != "" {
+		return nil, fmt.Errorf("invalid base URL %q: it can't have a query or fragment", raw)
+	}
+
+	switch parsed.Scheme {
+	case "unix":
+		if parsed.Host != "" || parsed.Path == "" {
+			return nil, fmt.Errorf("invalid base URL %q: expected unix:///path/to/socket", raw)
+		}
+		// The host only goes in the Host header, as the socket decides where the request goes
+		return &baseURL{
+			url:    &url.URL{Scheme: "http", Host: "localhost", Path: apiVersionPath},
+			socket: parsed.Path,
+		}, nil
+	case "http", "https":
+		if parsed.Host == "" {
+			return nil, fmt.Errorf("invalid base URL %q: missing host", raw)
+		}
+		if parsed.User != nil {
+			return nil, fmt.Errorf("invalid base URL %q: credentials go in Auth, not the URL", raw)
+		}
+		parsed.Path = strings.TrimSuffix(parsed.Path, "/")
+		parsed.RawPath = ""
+		if !strings.HasSuffix(parsed.Path, apiVersionPath) {
+			return nil, fmt.Errorf("invalid base URL %q: path must end with %s", raw, apiVersionPath)
+		}
+		return &baseURL{url: parsed}, nil
+	default:
+		return nil, fmt.Errorf("invalid base URL %q: scheme must be http, https or unix", raw)
+	}
+}
+
+// dialingTransport returns the transport connecting through the unix socket of the base URL or the dialer, if any.
+func (o *Options) dialingTransport(base *baseURL) (http.RoundTripper, error) {
+	dial := o.dialer
+	if base.socket != "" {
+		socketDial := dial
+		if socketDial == nil {
+			socketDial = (&net.Dialer{}).DialContext
+		}
+		dial = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return socketDial(ctx, "unix", base.socket)
+		}
+	}
+	if dial == nil {
+		return o.transport, nil
+	}
+
+	transport, ok := o.transport.(*http.Transport)
+	if !ok {
+		return nil, errors.New("a unix socket or Dialer needs the transport to be an *http.Transport")
+	}
+	transport = transport.Clone()
+	transport.DialContext = dial
+	return transport, nil
+}
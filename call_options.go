@@ -0,0 +1,80 @@
+package rediscloud_api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+)
+
+// CallOption changes how a single call is made, without needing another client - see WithCallOptions.
+type CallOption func(*internal.CallOptions)
+
+// RetryPolicy sets how requests refused with 429 Too Many Requests are retried. An Attempts of 0 or 1 disables
+// retrying.
+type RetryPolicy = internal.RetryPolicy
+
+// NotWaitedError is returned by calls creating a resource, in place of its ID, when CallNoWait left its task
+// processing. Its TaskID can be waited for with Tasks.Wait.
+type NotWaitedError = internal.NotWaitedError
+
+// WithCallOptions returns a context changing how the calls made with it are made, adding to any options already set
+// on ctx:
+//
+//	ctx = rediscloud_api.WithCallOptions(ctx, rediscloud_api.CallTimeout(5*time.Minute), rediscloud_api.CallLogRequests())
+//	id, err := client.Database.Create(ctx, subscription, database)
+func WithCallOptions(ctx context.Context, options ...CallOption) context.Context {
+	call := internal.CallOptionsFrom(ctx)
+	call.Headers = call.Headers.Clone()
+	for _, option := range options {
+		option(&call)
+	}
+	return internal.WithCallOptions(ctx, call)
+}
+
+// CallTimeout bounds the calls, including the waiting for their tasks, to end within timeout of WithCallOptions being
+// called - will default to the deadline of the context.
+func CallTimeout(timeout time.Duration) CallOption {
+	return func(options *internal.CallOptions) {
+		options.Deadline = time.Now().Add(timeout)
+	}
+}
+
+// CallNoWait returns from the calls once the API has accepted their request, leaving its task processing rather than
+// waiting for it - will default to waiting. onTask, if not nil, receives the ID of each task left processing, which
+// can be waited for with Tasks.Wait. As the resources of the tasks aren't known yet, calls creating one return a
+// *NotWaitedError holding the task instead of its ID, and others return zero values for them. Calls made of several
+// steps, such as DeleteCascade, may fail as a step depends on the previous one being done.
+func CallNoWait(onTask func(taskID string)) CallOption {
+	return func(options *internal.CallOptions) {
+		options.NoWait = true
+		options.OnTask = onTask
+	}
+}
+
+// CallRetries replaces how the client retries requests refused with 429 Too Many Requests - will default to the
+// client's own policy.
+func CallRetries(policy RetryPolicy) CallOption {
+	return func(options *internal.CallOptions) {
+		options.Retry = &policy
+	}
+}
+
+// CallLogRequests logs the requests and responses of the calls as LogRequests does, even when the client doesn't.
+func CallLogRequests() CallOption {
+	return func(options *internal.CallOptions) {
+		options.LogRequests = true
+	}
+}
+
+// CallHeader adds a header to the requests of the calls, e.g. to trace them through a proxy. The headers the client
+// sets itself, such as the credentials, can't be replaced.
+func CallHeader(key string, value string) CallOption {
+	return func(options *internal.CallOptions) {
+		if options.Headers == nil {
+			options.Headers = http.Header{}
+		}
+		options.Headers.Add(key, value)
+	}
+}
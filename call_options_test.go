@@ -0,0 +1,129 @@
+package rediscloud_api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallTimeout_boundsWaitingForTask(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		postRequest(t, "/acl/users", `{"name": "user"}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", `{"taskId": "task", "status": "processing-in-progress"}`),
+	))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	ctx := WithCallOptions(context.TODO(), CallTimeout(200*time.Millisecond))
+	_, err = subject.Users.Create(ctx, users.CreateUserRequest{Name: redis.String("user")})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestCallNoWait_leavesTaskProcessing(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		postRequest(t, "/acl/users", `{"name": "user"}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", `{"taskId": "task", "status": "processing-completed", "response": {"resourceId": 7}}`),
+	))
+	defer s.Close()
+
+	var out bytes.Buffer
+	subject, err := NewClient(BaseURL(s.URL+"/v1"), Auth("key", "secret"), Transporter(s.Client().Transport), Audit(NewAuditFile(&out)))
+	require.NoError(t, err)
+
+	var started []string
+	ctx := WithCallOptions(context.TODO(), CallNoWait(func(taskID string) {
+		started = append(started, taskID)
+	}))
+	actual, err := subject.Users.Create(ctx, users.CreateUserRequest{Name: redis.String("user")})
+	var notWaited *NotWaitedError
+	require.ErrorAs(t, err, &notWaited)
+	assert.Equal(t, "task", notWaited.TaskID)
+	assert.Equal(t, 0, actual)
+	assert.Equal(t, []string{"task"}, started)
+
+	events := readAudit(t, out.Bytes())
+	require.Len(t, events, 1)
+	assert.Equal(t, AuditAccepted, events[0].Status)
+
+	// The task is still waited for explicitly
+	task, err := subject.Tasks.Wait(ctx, "task")
+	require.NoError(t, err)
+	assert.Equal(t, 7, redis.IntValue(task.Response.ID))
+}
+
+func TestCallRetries(t *testing.T) {
+	tooManyRequests := endpointRequest{method: http.MethodGet, path: "/subscriptions", status: http.StatusTooManyRequests, t: t}
+
+	s := httptest.NewServer(testServer("key", "secret",
+		tooManyRequests,
+		tooManyRequests,
+		getRequest(t, "/subscriptions", `{"subscriptions": []}`),
+	))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	// Without retries, the first response is returned
+	_, err = subject.Subscription.List(WithCallOptions(context.TODO(), CallRetries(RetryPolicy{Attempts: 1})))
+	var httpErr *internal.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusTooManyRequests, httpErr.StatusCode)
+
+	_, err = subject.Subscription.List(WithCallOptions(context.TODO(), CallRetries(RetryPolicy{Attempts: 2, Delay: time.Millisecond, MaxDelay: time.Millisecond})))
+	require.NoError(t, err)
+}
+
+func TestCallLogRequests(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		getRequest(t, "/subscriptions", `{"subscriptions": []}`),
+		getRequest(t, "/subscriptions", `{"subscriptions": []}`),
+	))
+	defer s.Close()
+
+	logger := &mockedLogger{}
+	subject, err := NewClient(BaseURL(s.URL+"/v1"), Auth("key", "secret"), Transporter(s.Client().Transport), Logger(logger))
+	require.NoError(t, err)
+
+	_, err = subject.Subscription.List(context.TODO())
+	require.NoError(t, err)
+	assert.Empty(t, logger.log)
+
+	_, err = subject.Subscription.List(WithCallOptions(context.TODO(), CallLogRequests()))
+	require.NoError(t, err)
+	require.Len(t, logger.log, 2)
+	assert.True(t, strings.HasPrefix(logger.log[0], "DEBUG: Request /v1/subscriptions:"), logger.log[0])
+	assert.True(t, strings.HasPrefix(logger.log[1], "DEBUG: Response /v1/subscriptions:"), logger.log[1])
+}
+
+func TestCallHeader(t *testing.T) {
+	var received http.Header
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+		_, _ = w.Write([]byte(`{"subscriptions": []}`))
+	}))
+	defer s.Close()
+
+	subject, err := NewClient(BaseURL(s.URL+"/v1"), Auth("key", "secret"), Transporter(s.Client().Transport))
+	require.NoError(t, err)
+
+	ctx := WithCallOptions(context.TODO(), CallHeader("X-Request-Id", "first"))
+	ctx = WithCallOptions(ctx, CallHeader("X-Request-Id", "second"), CallHeader("X-Api-Key", "other"))
+	_, err = subject.Subscription.List(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"first", "second"}, received.Values("X-Request-Id"))
+	assert.Equal(t, "key", received.Get("X-Api-Key"))
+}
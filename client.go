@@ -2,12 +2,14 @@ package rediscloud_api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"log"
+	"log/slog"
 	"net/http"
 	"net/http/httputil"
-	"os"
-	"regexp"
 	"strings"
 
 	"github.com/RedisLabs/rediscloud-go-api/internal"
@@ -30,6 +32,7 @@ import (
 	"github.com/RedisLabs/rediscloud-go-api/service/regions"
 	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
 	"github.com/RedisLabs/rediscloud-go-api/service/tags"
+	"github.com/RedisLabs/rediscloud-go-api/service/tasks"
 	"github.com/RedisLabs/rediscloud-go-api/service/transit_gateway/attachments"
 )
 
@@ -47,6 +50,7 @@ type Client struct {
 	PrivateServiceConnect     *psc.API
 	PrivateLink               *privatelink.API
 	Tags                      *tags.API
+	Tasks                     *tasks.API
 	// fixed
 	FixedPlans             *plans.API
 	FixedSubscriptions     *fixedSubscriptions.API
@@ -60,10 +64,7 @@ type Client struct {
 
 func NewClient(configs ...Option) (*Client, error) {
 	config := &Options{
-		baseUrl:   "https://api.redislabs.com/v1",
 		userAgent: userAgent,
-		apiKey:    os.Getenv(AccessKeyEnvVar),
-		secretKey: os.Getenv(SecretKeyEnvVar),
 		logger:    &defaultLogger{},
 		transport: http.DefaultTransport,
 	}
@@ -72,18 +73,36 @@ func NewClient(configs ...Option) (*Client, error) {
 		option(config)
 	}
 
+	if err := config.resolve(); err != nil {
+		return nil, err
+	}
+
+	base, err := parseBaseURL(config.baseUrl)
+	if err != nil {
+		return nil, err
+	}
+	config.transport, err = config.dialingTransport(base)
+	if err != nil {
+		return nil, err
+	}
+
 	httpClient := &http.Client{
 		Transport: config.roundTripper(),
 	}
 
-	client, err := internal.NewHttpClient(httpClient, config.baseUrl, config.logger)
+	client, err := internal.NewHttpClient(httpClient, base.url.String(), config.logger)
 	if err != nil {
 		return nil, err
 	}
 
+	client.ValidateRequests(config.validateRequests)
+	if config.audit != nil {
+		client.Audit(internal.NewAuditor(config.audit, internal.NewRedactor(config.redactFields...), config.logger))
+	}
+
 	t := internal.NewAPI(client, config.logger)
 
-	return &Client{
+	c := &Client{
 		Account:                   account.NewAPI(client),
 		CloudAccount:              cloud_accounts.NewAPI(client, t, config.logger),
 		Database:                  databases.NewAPI(client, t, config.logger),
@@ -97,6 +116,7 @@ func NewClient(configs ...Option) (*Client, error) {
 		PrivateServiceConnect:     psc.NewAPI(client, t, config.logger),
 		PrivateLink:               privatelink.NewAPI(client, t, config.logger),
 		Tags:                      tags.NewAPI(client),
+		Tasks:                     tasks.NewAPI(client, t),
 		// fixed
 		FixedPlans:             plans.NewAPI(client, config.logger),
 		FixedPlanSubscriptions: plan_subscriptions.NewAPI(client, config.logger),
@@ -106,23 +126,49 @@ func NewClient(configs ...Option) (*Client, error) {
 		RedisRules: redis_rules.NewAPI(client, t, config.logger),
 		Roles:      roles.NewAPI(client, t, config.logger),
 		Users:      users.NewAPI(client, t, config.logger),
-	}, nil
+	}
+
+	c.Subscription.SetCascadeServices(subscriptions.CascadeServices{
+		Databases:             c.Database,
+		TransitGateways:       c.TransitGatewayAttachments,
+		PrivateServiceConnect: c.PrivateServiceConnect,
+		PrivateLink:           c.PrivateLink,
+	})
+	c.FixedSubscriptions.SetCascadeDatabases(c.FixedDatabases)
+
+	return c, nil
 }
 
+const defaultBaseUrl = "https://api.redislabs.com/v1"
+
 type Options struct {
 	baseUrl     string
 	apiKey      string
 	secretKey   string
+	credentials CredentialsProvider
 	userAgent   string
 	logger      Log
 	transport   http.RoundTripper
+	dialer      DialFunc
 	logRequests bool
+	// redactFields are hidden from logged requests and responses, along with internal.SecretFields
+	redactFields []string
+	audit        AuditSink
+
+	validateRequests bool
+
+	profile    string
+	configFile string
 }
 
 func (o Options) roundTripper() http.RoundTripper {
+	provider := o.credentials
+	if provider == nil {
+		provider = StaticCredentials(o.apiKey, o.secretKey)
+	}
 	return &credentialTripper{
-		apiKey:      o.apiKey,
-		secretKey:   o.secretKey,
+		credentials: newCredentialsCache(provider),
+		redactor:    internal.NewRedactor(o.redactFields...),
 		wrapped:     o.transport,
 		logRequests: o.logRequests,
 		logger:      o.logger,
@@ -133,7 +179,7 @@ func (o Options) roundTripper() http.RoundTripper {
 type Option func(*Options)
 
 // Auth is used to set the authentication credentials - will otherwise default to using environment variables
-// for the credentials.
+// for the credentials, or a profile of the config file (see Profile). Use AuthProvider for credentials which change.
 func Auth(apiKey string, secretKey string) Option {
 	return func(options *Options) {
 		options.apiKey = apiKey
@@ -141,7 +187,9 @@ func Auth(apiKey string, secretKey string) Option {
 	}
 }
 
-// BaseURL sets the URL to use for the API endpoint - will default to `https://api.redislabs.com/v1`.
+// BaseURL sets the URL to use for the API endpoint - will otherwise default to the `REDISCLOUD_URL` environment
+// variable or a profile of the config file (see Profile), and then to `https://api.redislabs.com/v1`. The URL must
+// be http or https and end with `/v1`, or be `unix:///path/to/socket` to reach the API through a local proxy.
 func BaseURL(url string) Option {
 	return func(options *Options) {
 		options.baseUrl = url
@@ -155,6 +203,26 @@ func LogRequests(enable bool) Option {
 	}
 }
 
+// RedactFields hides the values of more JSON properties in the requests and responses logged with LogRequests.
+// Passwords, cloud provider keys, certificates and the credentials of URIs are always hidden.
+func RedactFields(fields ...string) Option {
+	return func(options *Options) {
+		options.redactFields = append(options.redactFields, fields...)
+	}
+}
+
+// ValidateRequests checks requests with the `Validate()` method of their type before sending them, so that invalid
+// requests fail with a *ValidationError listing every problem without contacting the API - will default to false
+// (disabled).
+func ValidateRequests(enable bool) Option {
+	return func(options *Options) {
+		options.validateRequests = enable
+	}
+}
+
+// ValidationError lists the problems found with a request when ValidateRequests is enabled.
+type ValidationError = internal.ValidationError
+
 // Transporter allows the customisation of the RoundTripper used to communicate with the API - will default to the
 // Go default.
 func Transporter(transporter http.RoundTripper) Option {
@@ -178,11 +246,40 @@ func Logger(log Log) Option {
 	}
 }
 
+// LogHandler sends the log entries of the SDK to a slog.Handler, with levels and attributes such as `operation`,
+// `subscription_id`, `database_id`, `task_id`, `http_status` and `duration` - replaces the logger set with Logger.
+// Requests and task polling are logged at debug level, the lifecycle of resources at info level and requests
+// retried after 429 Too Many Requests at warn level.
+func LogHandler(handler slog.Handler) Option {
+	return func(options *Options) {
+		options.logger = &slogLogger{logger: slog.New(handler)}
+	}
+}
+
 type Log interface {
 	Printf(format string, v ...interface{})
 	Println(v ...interface{})
 }
 
+// slogLogger is the Log of the SDK when a slog.Handler is set. Entries written without a level are at info level.
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+func (s *slogLogger) Printf(format string, v ...interface{}) {
+	s.logger.Info(fmt.Sprintf(format, v...))
+}
+
+func (s *slogLogger) Println(v ...interface{}) {
+	s.logger.Info(strings.TrimSuffix(fmt.Sprintln(v...), "\n"))
+}
+
+func (s *slogLogger) LogAttrs(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	s.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+var _ internal.StructuredLog = &slogLogger{}
+
 type defaultLogger struct{}
 
 func (d *defaultLogger) Printf(format string, v ...interface{}) {
@@ -194,8 +291,8 @@ func (d *defaultLogger) Println(v ...interface{}) {
 }
 
 type credentialTripper struct {
-	apiKey      string
-	secretKey   string
+	credentials *credentialsCache
+	redactor    *internal.Redactor
 	wrapped     http.RoundTripper
 	logRequests bool
 	logger      Log
@@ -206,35 +303,98 @@ func (c *credentialTripper) RoundTrip(request *http.Request) (*http.Response, er
 	request.Header.Set("Accept", "application/json")
 	request.Header.Set("User-Agent", c.userAgent)
 
-	if c.logRequests {
+	logRequests := c.logRequests || internal.CallOptionsFrom(request.Context()).LogRequests
+	if logRequests {
 		data, _ := httputil.DumpRequestOut(request, true)
 		if data != nil {
-			c.logger.Printf(`DEBUG: Request %s:
----[ REQUEST ]---
-%s`, escapePath(request.URL.Path), redactPasswords(prettyPrint(data)))
+			c.debug(request, "Request", "REQUEST", prettyPrint(c.redact(data)))
 		}
 	}
 
 	// Credentials added _after_ the request was logged to avoid accidentally logging them
-	request.Header.Set("X-Api-Key", c.apiKey)
-	request.Header.Set("X-Api-Secret-Key", c.secretKey)
+	credentials, err := c.credentials.get(request.Context())
+	if err != nil {
+		return nil, err
+	}
+	setCredentials(request, credentials)
 
 	response, err := c.wrapped.RoundTrip(request)
 	if err != nil {
 		return response, err
 	}
 
-	if c.logRequests {
+	if response.StatusCode == http.StatusUnauthorized {
+		response, err = c.retryUnauthorized(request, response, credentials)
+		if err != nil {
+			return response, err
+		}
+	}
+
+	if logRequests {
 		data, _ := httputil.DumpResponse(response, true)
 		if data != nil {
-			c.logger.Printf(`DEBUG: Response %s:
----[ RESPONSE ]---
-%s`, escapePath(request.URL.Path), redactPasswords(prettyPrint(data)))
+			c.debug(request, "Response", "RESPONSE", prettyPrint(c.redact(data)))
 		}
 	}
 	return response, nil
 }
 
+// debug logs a request or response dumped by LogRequests, as an attribute of an entry for structured loggers.
+func (c *credentialTripper) debug(request *http.Request, kind string, banner string, dump string) {
+	path := escapePath(request.URL.Path)
+	if logger, ok := c.logger.(internal.StructuredLog); ok {
+		logger.LogAttrs(request.Context(), slog.LevelDebug, kind, slog.String("method", request.Method),
+			slog.String("path", path), slog.String("dump", dump))
+		return
+	}
+	c.logger.Printf(`DEBUG: %s %s:
+---[ %s ]---
+%s`, kind, path, banner, dump)
+}
+
+// retryUnauthorized sends a request refused with 401 Unauthorized once more, when the credentials have changed since
+// it was sent, e.g. because the keys were rotated. The first response is kept otherwise, as it explains the failure.
+func (c *credentialTripper) retryUnauthorized(request *http.Request, response *http.Response, used Credentials) (*http.Response, error) {
+	if request.Body != nil && request.Body != http.NoBody && request.GetBody == nil {
+		// The body has been consumed and can't be sent again
+		return response, nil
+	}
+
+	credentials, changed, err := c.credentials.refresh(request.Context(), used)
+	if err != nil {
+		internal.LogAttrs(request.Context(), c.logger, slog.LevelWarn,
+			fmt.Sprintf("Not retrying %s after 401 Unauthorized: %s", escapePath(request.URL.Path), err),
+			internal.HTTPStatus(response.StatusCode), slog.Any("error", err))
+		return response, nil
+	}
+	if !changed {
+		return response, nil
+	}
+
+	retry := request.Clone(request.Context())
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return response, nil
+		}
+		retry.Body = body
+	}
+	setCredentials(retry, credentials)
+
+	_, _ = io.Copy(io.Discard, response.Body)
+	_ = response.Body.Close()
+
+	internal.LogAttrs(request.Context(), c.logger, slog.LevelInfo,
+		fmt.Sprintf("Retrying %s with refreshed credentials after 401 Unauthorized", escapePath(request.URL.Path)),
+		internal.HTTPStatus(response.StatusCode))
+	return c.wrapped.RoundTrip(retry)
+}
+
+func setCredentials(request *http.Request, credentials Credentials) {
+	request.Header.Set("X-Api-Key", credentials.APIKey)
+	request.Header.Set("X-Api-Secret-Key", credentials.SecretKey)
+}
+
 func prettyPrint(data []byte) string {
 	lines := strings.Split(string(data), "\n")
 	// A JSON body that wasn't indented would have ended up as a single line in the dumped information,
@@ -251,12 +411,15 @@ func prettyPrint(data []byte) string {
 	return strings.Join(lines, "\n")
 }
 
-// redactPasswords: Redacts password values from a JSON message.
-func redactPasswords(data string) string {
-	m1 := regexp.MustCompile(`"password"\s*:\s*"(?:[^"\\]|\\.)*"`)
-	output := m1.ReplaceAllString(data, "\"password\": \"REDACTED\"")
-	m2 := regexp.MustCompile(`"global_password"\s*:\s*"(?:[^"\\]|\\.)*"`)
-	return m2.ReplaceAllString(output, "\"global_password\": \"REDACTED\"")
+var defaultRedactor = internal.NewRedactor()
+
+// redact hides the secrets of a dumped request or response.
+func (c *credentialTripper) redact(data []byte) []byte {
+	redactor := c.redactor
+	if redactor == nil {
+		redactor = defaultRedactor
+	}
+	return []byte(redactor.Redact(string(data)))
 }
 
 func escapePath(path string) string {
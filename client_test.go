@@ -40,8 +40,7 @@ func TestCredentialTripper_DisabledLoggingLogsNothing(t *testing.T) {
 	mockTripper.On("RoundTrip", request).Return(expected, nil)
 
 	subject := &credentialTripper{
-		apiKey:      "KEY THAT SHOULD NOT BE LOGGED",
-		secretKey:   "SECRET KEY THAT SHOULD NOT BE LOGGED",
+		credentials: newCredentialsCache(StaticCredentials("KEY THAT SHOULD NOT BE LOGGED", "SECRET KEY THAT SHOULD NOT BE LOGGED")),
 		wrapped:     mockTripper,
 		logRequests: false,
 		logger:      mockLogger,
@@ -87,8 +86,7 @@ func TestCredentialTripper_LogsRequestAndResponseBodies(t *testing.T) {
 	mockTripper.On("RoundTrip", request).Return(expected, nil)
 
 	subject := &credentialTripper{
-		apiKey:      "KEY THAT SHOULD NOT BE LOGGED",
-		secretKey:   "SECRET KEY THAT SHOULD NOT BE LOGGED",
+		credentials: newCredentialsCache(StaticCredentials("KEY THAT SHOULD NOT BE LOGGED", "SECRET KEY THAT SHOULD NOT BE LOGGED")),
 		wrapped:     mockTripper,
 		logRequests: true,
 		logger:      mockLogger,
@@ -153,8 +151,7 @@ func TestCredentialTripper_HandlesNoBodies(t *testing.T) {
 	mockTripper.On("RoundTrip", request).Return(expected, nil)
 
 	subject := &credentialTripper{
-		apiKey:      "KEY THAT SHOULD NOT BE LOGGED",
-		secretKey:   "SECRET KEY THAT SHOULD NOT BE LOGGED",
+		credentials: newCredentialsCache(StaticCredentials("KEY THAT SHOULD NOT BE LOGGED", "SECRET KEY THAT SHOULD NOT BE LOGGED")),
 		wrapped:     mockTripper,
 		logRequests: true,
 		logger:      mockLogger,
@@ -211,8 +208,7 @@ func TestCredentialTripper_RedactPasswordFromBody(t *testing.T) {
 	mockTripper.On("RoundTrip", request).Return(expected, nil)
 
 	subject := &credentialTripper{
-		apiKey:      "KEY THAT SHOULD NOT BE LOGGED",
-		secretKey:   "SECRET KEY THAT SHOULD NOT BE LOGGED",
+		credentials: newCredentialsCache(StaticCredentials("KEY THAT SHOULD NOT BE LOGGED", "SECRET KEY THAT SHOULD NOT BE LOGGED")),
 		wrapped:     mockTripper,
 		logRequests: true,
 		logger:      mockLogger,
@@ -268,8 +264,7 @@ func TestCredentialTripper_RedactPasswordFromNestedBody(t *testing.T) {
 	mockTripper.On("RoundTrip", request).Return(expected, nil)
 
 	subject := &credentialTripper{
-		apiKey:      "KEY THAT SHOULD NOT BE LOGGED",
-		secretKey:   "SECRET KEY THAT SHOULD NOT BE LOGGED",
+		credentials: newCredentialsCache(StaticCredentials("KEY THAT SHOULD NOT BE LOGGED", "SECRET KEY THAT SHOULD NOT BE LOGGED")),
 		wrapped:     mockTripper,
 		logRequests: true,
 		logger:      mockLogger,
@@ -142,7 +142,7 @@ func TestCloudAccount_Get(t *testing.T) {
 		ID:          redis.Int(97643),
 		Name:        redis.String("Frank"),
 		Provider:    redis.String("GCP"),
-		Status:      redis.String("active"),
+		Status:      redis.Ptr(cloud_accounts.StatusActive),
 		AccessKeyID: redis.String("keyId"),
 	}, actual)
 }
@@ -196,14 +196,14 @@ func TestCloudAccount_List(t *testing.T) {
 			ID:          redis.Int(1),
 			Name:        redis.String("first one"),
 			Provider:    redis.String("AWS"),
-			Status:      redis.String("active"),
+			Status:      redis.Ptr(cloud_accounts.StatusActive),
 			AccessKeyID: nil,
 		},
 		{
 			ID:          redis.Int(9876),
 			Name:        redis.String("custom"),
 			Provider:    redis.String("AWS"),
-			Status:      redis.String("active"),
+			Status:      redis.Ptr(cloud_accounts.StatusActive),
 			AccessKeyID: redis.String("someKeyId"),
 		},
 	}, actual)
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+
+	rediscloud_api "github.com/RedisLabs/rediscloud-go-api"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/redis_rules"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/roles"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/users"
+)
+
+var aclCommand = &command{
+	name:    "acl",
+	summary: "Manage the access control lists of the account.",
+	commands: []*command{
+		aclResource[users.CreateUserRequest, users.UpdateUserRequest]{
+			name:   "user",
+			plural: "users",
+			list: func(ctx context.Context, c *rediscloud_api.Client) (interface{}, error) {
+				return c.Users.List(ctx)
+			},
+			get: func(ctx context.Context, c *rediscloud_api.Client, id int) (interface{}, error) {
+				return c.Users.Get(ctx, id)
+			},
+			create: func(ctx context.Context, c *rediscloud_api.Client, request users.CreateUserRequest) (int, error) {
+				return c.Users.Create(ctx, request)
+			},
+			update: func(ctx context.Context, c *rediscloud_api.Client, id int, request users.UpdateUserRequest) error {
+				return c.Users.Update(ctx, id, request)
+			},
+			delete: func(ctx context.Context, c *rediscloud_api.Client, id int) error {
+				return c.Users.Delete(ctx, id)
+			},
+		}.command(),
+		aclResource[roles.CreateRoleRequest, roles.CreateRoleRequest]{
+			name:   "role",
+			plural: "roles",
+			list: func(ctx context.Context, c *rediscloud_api.Client) (interface{}, error) {
+				return c.Roles.List(ctx)
+			},
+			get: func(ctx context.Context, c *rediscloud_api.Client, id int) (interface{}, error) {
+				return c.Roles.Get(ctx, id)
+			},
+			create: func(ctx context.Context, c *rediscloud_api.Client, request roles.CreateRoleRequest) (int, error) {
+				return c.Roles.Create(ctx, request)
+			},
+			update: func(ctx context.Context, c *rediscloud_api.Client, id int, request roles.CreateRoleRequest) error {
+				return c.Roles.Update(ctx, id, request)
+			},
+			delete: func(ctx context.Context, c *rediscloud_api.Client, id int) error {
+				return c.Roles.Delete(ctx, id)
+			},
+		}.command(),
+		aclResource[redis_rules.CreateRedisRuleRequest, redis_rules.CreateRedisRuleRequest]{
+			name:   "rule",
+			plural: "Redis rules",
+			list: func(ctx context.Context, c *rediscloud_api.Client) (interface{}, error) {
+				return c.RedisRules.List(ctx)
+			},
+			get: func(ctx context.Context, c *rediscloud_api.Client, id int) (interface{}, error) {
+				return c.RedisRules.Get(ctx, id)
+			},
+			create: func(ctx context.Context, c *rediscloud_api.Client, request redis_rules.CreateRedisRuleRequest) (int, error) {
+				return c.RedisRules.Create(ctx, request)
+			},
+			update: func(ctx context.Context, c *rediscloud_api.Client, id int, request redis_rules.CreateRedisRuleRequest) error {
+				return c.RedisRules.Update(ctx, id, request)
+			},
+			delete: func(ctx context.Context, c *rediscloud_api.Client, id int) error {
+				return c.RedisRules.Delete(ctx, id)
+			},
+		}.command(),
+	},
+}
+
+// aclResource describes the calls behind the commands of users, roles and rules, which all follow the same shape.
+type aclResource[Create, Update any] struct {
+	name   string
+	plural string
+	list   func(ctx context.Context, c *rediscloud_api.Client) (interface{}, error)
+	get    func(ctx context.Context, c *rediscloud_api.Client, id int) (interface{}, error)
+	create func(ctx context.Context, c *rediscloud_api.Client, request Create) (int, error)
+	update func(ctx context.Context, c *rediscloud_api.Client, id int, request Update) error
+	delete func(ctx context.Context, c *rediscloud_api.Client, id int) error
+}
+
+func (r aclResource[Create, Update]) command() *command {
+	arg := "ID"
+	return &command{
+		name:    r.name,
+		summary: "Manage " + r.plural + ".",
+		commands: []*command{
+			{
+				name:    "list",
+				summary: "List the " + r.plural + " of the account.",
+				output:  true,
+				run: func(ctx context.Context, inv *invocation) error {
+					if _, err := inv.parse(); err != nil {
+						return err
+					}
+					client, err := inv.client()
+					if err != nil {
+						return err
+					}
+					list, err := r.list(ctx, client)
+					if err != nil {
+						return err
+					}
+					return inv.print(list)
+				},
+			},
+			{
+				name:    "get",
+				args:    arg,
+				summary: "Show one of the " + r.plural + ".",
+				output:  true,
+				run: func(ctx context.Context, inv *invocation) error {
+					id, client, err := aclArgs(inv)
+					if err != nil {
+						return err
+					}
+					resource, err := r.get(ctx, client, id)
+					if err != nil {
+						return err
+					}
+					return inv.print(resource)
+				},
+			},
+			{
+				name:    "create",
+				summary: "Create one of the " + r.plural + ", and wait for it to be active.",
+				output:  true,
+				run: func(ctx context.Context, inv *invocation) error {
+					file := inv.fileFlag()
+					if _, err := inv.parse(); err != nil {
+						return err
+					}
+					var request Create
+					if err := inv.read(*file, &request); err != nil {
+						return err
+					}
+					client, err := inv.client()
+					if err != nil {
+						return err
+					}
+					id, err := r.create(ctx, client, request)
+					if err != nil {
+						return err
+					}
+					return inv.print(created{ID: id})
+				},
+			},
+			{
+				name:    "update",
+				args:    arg,
+				summary: "Update one of the " + r.plural + ", and wait for the change to be applied.",
+				run: func(ctx context.Context, inv *invocation) error {
+					file := inv.fileFlag()
+					args, err := inv.parse()
+					if err != nil {
+						return err
+					}
+					id, err := inv.id(args, 0)
+					if err != nil {
+						return err
+					}
+					var request Update
+					if err := inv.read(*file, &request); err != nil {
+						return err
+					}
+					client, err := inv.client()
+					if err != nil {
+						return err
+					}
+					if err := r.update(ctx, client, id, request); err != nil {
+						return err
+					}
+					return inv.done("Updated %s %d", r.name, id)
+				},
+			},
+			{
+				name:    "delete",
+				args:    arg,
+				summary: "Delete one of the " + r.plural + ".",
+				run: func(ctx context.Context, inv *invocation) error {
+					id, client, err := aclArgs(inv)
+					if err != nil {
+						return err
+					}
+					if err := r.delete(ctx, client, id); err != nil {
+						return err
+					}
+					return inv.done("Deleted %s %d", r.name, id)
+				},
+			},
+		},
+	}
+}
+
+func aclArgs(inv *invocation) (int, *rediscloud_api.Client, error) {
+	args, err := inv.parse()
+	if err != nil {
+		return 0, nil, err
+	}
+	id, err := inv.id(args, 0)
+	if err != nil {
+		return 0, nil, err
+	}
+	client, err := inv.client()
+	if err != nil {
+		return 0, nil, err
+	}
+	return id, client, nil
+}
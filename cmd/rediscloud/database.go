@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+)
+
+var databaseCommand = &command{
+	name:    "database",
+	summary: "Manage the databases of Pro subscriptions.",
+	commands: []*command{
+		{
+			name:    "list",
+			args:    "SUBSCRIPTION",
+			summary: "List the databases of a subscription.",
+			output:  true,
+			run: func(ctx context.Context, inv *invocation) error {
+				args, err := inv.parse()
+				if err != nil {
+					return err
+				}
+				subscription, err := inv.id(args, 0)
+				if err != nil {
+					return err
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				list := client.Database.List(ctx, subscription)
+				all := []*databases.Database{}
+				for list.Next() {
+					all = append(all, list.Value())
+				}
+				if err := list.Err(); err != nil {
+					return err
+				}
+				return inv.print(all)
+			},
+		},
+		{
+			name:    "get",
+			args:    "SUBSCRIPTION DATABASE",
+			summary: "Show a database.",
+			output:  true,
+			run: func(ctx context.Context, inv *invocation) error {
+				subscription, database, err := databaseArgs(inv)
+				if err != nil {
+					return err
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				db, err := client.Database.Get(ctx, subscription, database)
+				if err != nil {
+					return err
+				}
+				return inv.print(db)
+			},
+		},
+		{
+			name:    "update",
+			args:    "SUBSCRIPTION DATABASE",
+			summary: "Update a database, and wait for the change to be applied.",
+			run: func(ctx context.Context, inv *invocation) error {
+				file := inv.fileFlag()
+				subscription, database, err := databaseArgs(inv)
+				if err != nil {
+					return err
+				}
+				var request databases.UpdateDatabase
+				if err := inv.read(*file, &request); err != nil {
+					return err
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				if err := client.Database.Update(ctx, subscription, database, request); err != nil {
+					return err
+				}
+				return inv.done("Updated database %d in subscription %d", database, subscription)
+			},
+		},
+		{
+			name:    "backup",
+			args:    "SUBSCRIPTION DATABASE",
+			summary: "Back up a database to its configured remote storage.",
+			run: func(ctx context.Context, inv *invocation) error {
+				subscription, database, err := databaseArgs(inv)
+				if err != nil {
+					return err
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				if err := client.Database.Backup(ctx, subscription, database); err != nil {
+					return err
+				}
+				return inv.done("Backed up database %d in subscription %d", database, subscription)
+			},
+		},
+		{
+			name:    "import",
+			args:    "SUBSCRIPTION DATABASE",
+			summary: "Import data into a database, replacing its content.",
+			run: func(ctx context.Context, inv *invocation) error {
+				file := inv.fileFlag()
+				subscription, database, err := databaseArgs(inv)
+				if err != nil {
+					return err
+				}
+				var request databases.Import
+				if err := inv.read(*file, &request); err != nil {
+					return err
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				if err := client.Database.Import(ctx, subscription, database, request); err != nil {
+					return err
+				}
+				return inv.done("Imported data into database %d in subscription %d", database, subscription)
+			},
+		},
+		{
+			name:    "upgrade",
+			args:    "SUBSCRIPTION DATABASE",
+			summary: "Upgrade a database to another Redis version.",
+			run: func(ctx context.Context, inv *invocation) error {
+				version := inv.flags.String("version", "", "Redis `version` to upgrade to (required)")
+				subscription, database, err := databaseArgs(inv)
+				if err != nil {
+					return err
+				}
+				if *version == "" {
+					return usageErrorf("-version is required")
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				request := databases.UpgradeRedisVersion{TargetRedisVersion: version}
+				if err := client.Database.UpgradeRedisVersion(ctx, subscription, database, request); err != nil {
+					return err
+				}
+				return inv.done("Upgraded database %d in subscription %d to Redis %s", database, subscription, *version)
+			},
+		},
+	},
+}
+
+// databaseArgs parses the arguments of the commands acting on a single database.
+func databaseArgs(inv *invocation) (int, int, error) {
+	args, err := inv.parse()
+	if err != nil {
+		return 0, 0, err
+	}
+	subscription, err := inv.id(args, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	database, err := inv.id(args, 1)
+	if err != nil {
+		return 0, 0, err
+	}
+	return subscription, database, nil
+}
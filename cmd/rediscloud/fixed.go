@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+)
+
+var fixedCommand = &command{
+	name:    "fixed",
+	summary: "Browse Essentials (fixed) resources.",
+	commands: []*command{
+		{
+			name:    "plan",
+			summary: "Browse the plans Essentials subscriptions are created from.",
+			commands: []*command{
+				{
+					name:    "list",
+					summary: "List the available plans.",
+					output:  true,
+					run: func(ctx context.Context, inv *invocation) error {
+						provider := inv.flags.String("provider", "", "only list the plans of a cloud `provider` (AWS, GCP or Azure)")
+						if _, err := inv.parse(); err != nil {
+							return err
+						}
+						client, err := inv.client()
+						if err != nil {
+							return err
+						}
+						if *provider != "" {
+							plans, err := client.FixedPlans.ListWithProvider(ctx, *provider)
+							if err != nil {
+								return err
+							}
+							return inv.print(plans)
+						}
+						plans, err := client.FixedPlans.List(ctx)
+						if err != nil {
+							return err
+						}
+						return inv.print(plans)
+					},
+				},
+			},
+		},
+	},
+}
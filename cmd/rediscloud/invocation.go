@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	rediscloud_api "github.com/RedisLabs/rediscloud-go-api"
+	"gopkg.in/yaml.v3"
+)
+
+// invocation is a single run of a command.
+type invocation struct {
+	cli     *cli
+	cmd     *command
+	flags   *flag.FlagSet
+	args    []string
+	profile *string
+	// output is the -output flag of the commands printing a result
+	output  *string
+	printer printer
+}
+
+// parse parses the flags, which can come before or after the positional arguments, and returns the arguments once
+// checked there are as many as the command names.
+func (inv *invocation) parse() ([]string, error) {
+	var positional []string
+	args := inv.args
+	for {
+		if err := inv.flags.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			// The flag package has already reported the problem
+			return nil, usageErrorf("invalid flags")
+		}
+		args = inv.flags.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	if inv.output != nil {
+		printer, err := newPrinter(*inv.output)
+		if err != nil {
+			return nil, err
+		}
+		inv.printer = printer
+	}
+
+	names := strings.Fields(inv.cmd.args)
+	if len(positional) != len(names) {
+		if len(names) == 0 {
+			return nil, usageErrorf("%s takes no arguments", inv.flags.Name())
+		}
+		return nil, usageErrorf("%s takes %d argument(s): %s", inv.flags.Name(), len(names), inv.cmd.args)
+	}
+	return positional, nil
+}
+
+// id parses an argument holding the identifier of a resource.
+func (inv *invocation) id(args []string, i int) (int, error) {
+	id, err := strconv.Atoi(args[i])
+	if err != nil || id <= 0 {
+		return 0, usageErrorf("%s must be a positive number, got %q", strings.Fields(inv.cmd.args)[i], args[i])
+	}
+	return id, nil
+}
+
+func (inv *invocation) client() (*rediscloud_api.Client, error) {
+	// Credentials and the URL come from the environment or the config file, as for any other user of the SDK
+	options := []rediscloud_api.Option{
+		rediscloud_api.AdditionalUserAgent("rediscloud-cli"),
+		rediscloud_api.ValidateRequests(true),
+		rediscloud_api.Logger(quietLogger{}),
+	}
+	if *inv.profile != "" {
+		options = append(options, rediscloud_api.Profile(*inv.profile))
+	}
+	return rediscloud_api.NewClient(options...)
+}
+
+// print writes a result to stdout, in the format chosen with -output.
+func (inv *invocation) print(v interface{}) error {
+	var out bytes.Buffer
+	if err := inv.printer(&out, v); err != nil {
+		return err
+	}
+	// Templates rarely end with a new line, which would leave the shell's prompt on the same line
+	if out.Len() > 0 && !bytes.HasSuffix(out.Bytes(), []byte("\n")) {
+		out.WriteByte('\n')
+	}
+	_, err := inv.cli.stdout.Write(out.Bytes())
+	return err
+}
+
+// done reports the success of a command without any result on stderr, leaving stdout empty.
+func (inv *invocation) done(format string, args ...interface{}) error {
+	_, err := fmt.Fprintf(inv.cli.stderr, format+"\n", args...)
+	return err
+}
+
+// fileFlag defines the flag giving the file a request body is read from.
+func (inv *invocation) fileFlag() *string {
+	return inv.flags.String("file", "", "JSON or YAML `file` holding the request, or - for stdin (required)")
+}
+
+// read decodes the request in a JSON or YAML file into v, refusing fields the request doesn't have.
+func (inv *invocation) read(file string, v interface{}) error {
+	if file == "" {
+		return usageErrorf("-file is required")
+	}
+
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = io.ReadAll(inv.cli.stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return err
+	}
+
+	// YAML is a superset of JSON, so both are read the same way and then handed to encoding/json, which knows how
+	// the request types are decoded
+	var document interface{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	data, err = json.Marshal(document)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", file, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	return nil
+}
+
+// quietLogger drops the SDK's debug messages, such as task progress, which would clutter the output.
+type quietLogger struct{}
+
+func (quietLogger) Printf(string, ...interface{}) {}
+
+func (quietLogger) Println(...interface{}) {}
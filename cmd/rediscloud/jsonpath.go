@@ -0,0 +1,308 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a template in the JSONPath dialect of kubectl: text with expressions in braces, such as
+// `{.name}` or `{range [*]}{.id}{"\t"}{.name}{"\n"}{end}`.
+//
+// Expressions start at the current value, optionally written `$`, and are made of `.key`, `['key']`, `[index]`,
+// `[*]` or `.*` for every item, and `..key` for the key at any depth.
+type jsonPath struct {
+	nodes []pathNode
+}
+
+type pathNode struct {
+	// text is written as-is when there are no steps
+	text  string
+	steps []pathStep
+	// body is repeated for every value of steps when the node is a range
+	body    []pathNode
+	isRange bool
+}
+
+type stepKind int
+
+const (
+	stepKey stepKind = iota
+	stepIndex
+	stepWildcard
+	stepDescendant
+)
+
+type pathStep struct {
+	kind  stepKind
+	key   string
+	index int
+}
+
+func parseJSONPath(template string) (*jsonPath, error) {
+	// stack holds the body of each range being parsed, the outermost being the template itself
+	stack := [][]pathNode{nil}
+	var ranges []pathNode
+
+	for len(template) > 0 {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			stack[len(stack)-1] = append(stack[len(stack)-1], pathNode{text: template})
+			break
+		}
+		if start > 0 {
+			stack[len(stack)-1] = append(stack[len(stack)-1], pathNode{text: template[:start]})
+		}
+		end := closingBrace(template, start)
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed expression %q", template[start:])
+		}
+		expression := strings.TrimSpace(template[start+1 : end])
+		template = template[end+1:]
+
+		switch {
+		case strings.HasPrefix(expression, `"`) || strings.HasPrefix(expression, "'"):
+			text, err := unquote(expression)
+			if err != nil {
+				return nil, fmt.Errorf("invalid string %s", expression)
+			}
+			stack[len(stack)-1] = append(stack[len(stack)-1], pathNode{text: text})
+		case expression == "end":
+			if len(ranges) == 0 {
+				return nil, errors.New("{end} without {range}")
+			}
+			node := ranges[len(ranges)-1]
+			node.body = stack[len(stack)-1]
+			ranges, stack = ranges[:len(ranges)-1], stack[:len(stack)-1]
+			stack[len(stack)-1] = append(stack[len(stack)-1], node)
+		case expression == "range" || strings.HasPrefix(expression, "range "):
+			steps, err := parseSteps(strings.TrimSpace(strings.TrimPrefix(expression, "range")))
+			if err != nil {
+				return nil, err
+			}
+			ranges = append(ranges, pathNode{steps: steps, isRange: true})
+			stack = append(stack, nil)
+		default:
+			steps, err := parseSteps(expression)
+			if err != nil {
+				return nil, err
+			}
+			stack[len(stack)-1] = append(stack[len(stack)-1], pathNode{steps: steps})
+		}
+	}
+
+	if len(ranges) > 0 {
+		return nil, errors.New("{range} without {end}")
+	}
+	return &jsonPath{nodes: stack[0]}, nil
+}
+
+// closingBrace returns the index of the brace closing the one at start, skipping over quoted strings.
+func closingBrace(s string, start int) int {
+	var quote byte
+	for i := start + 1; i < len(s); i++ {
+		switch {
+		case quote != 0 && s[i] == '\\':
+			i++
+		case quote != 0 && s[i] == quote:
+			quote = 0
+		case quote != 0:
+		case s[i] == '"' || s[i] == '\'':
+			quote = s[i]
+		case s[i] == '}':
+			return i
+		}
+	}
+	return -1
+}
+
+func parseSteps(expression string) ([]pathStep, error) {
+	rest := strings.TrimPrefix(expression, "$")
+	// The path of the current value itself
+	if rest == "." || rest == "" {
+		return []pathStep{}, nil
+	}
+
+	var steps []pathStep
+	for len(rest) > 0 {
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			key, remaining := cutKey(rest[2:])
+			if key == "" {
+				return nil, fmt.Errorf("missing key after .. in %q", expression)
+			}
+			steps = append(steps, pathStep{kind: stepDescendant, key: key})
+			rest = remaining
+		case strings.HasPrefix(rest, ".*"):
+			steps = append(steps, pathStep{kind: stepWildcard})
+			rest = rest[2:]
+		case strings.HasPrefix(rest, "."):
+			key, remaining := cutKey(rest[1:])
+			if key == "" {
+				return nil, fmt.Errorf("missing key after . in %q", expression)
+			}
+			steps = append(steps, pathStep{kind: stepKey, key: key})
+			rest = remaining
+		case strings.HasPrefix(rest, "["):
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed [ in %q", expression)
+			}
+			inside := strings.TrimSpace(rest[1:end])
+			rest = rest[end+1:]
+			if inside == "*" {
+				steps = append(steps, pathStep{kind: stepWildcard})
+				continue
+			}
+			if strings.HasPrefix(inside, "'") || strings.HasPrefix(inside, `"`) {
+				key, err := unquote(inside)
+				if err != nil {
+					return nil, fmt.Errorf("invalid key [%s] in %q", inside, expression)
+				}
+				steps = append(steps, pathStep{kind: stepKey, key: key})
+				continue
+			}
+			index, err := strconv.Atoi(inside)
+			if err != nil {
+				return nil, fmt.Errorf("invalid index [%s] in %q", inside, expression)
+			}
+			steps = append(steps, pathStep{kind: stepIndex, index: index})
+		default:
+			// A leading key may be written without its dot
+			if len(steps) > 0 {
+				return nil, fmt.Errorf("unexpected %q in %q", rest, expression)
+			}
+			rest = "." + rest
+		}
+	}
+	return steps, nil
+}
+
+// unquote reads a string in double or single quotes.
+func unquote(s string) (string, error) {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		s = `"` + strings.ReplaceAll(s[1:len(s)-1], `"`, `\"`) + `"`
+	}
+	return strconv.Unquote(s)
+}
+
+func cutKey(s string) (string, string) {
+	end := strings.IndexAny(s, ".[")
+	if end < 0 {
+		return s, ""
+	}
+	return s[:end], s[end:]
+}
+
+func (p *jsonPath) execute(w io.Writer, data interface{}) error {
+	return executeNodes(w, p.nodes, data)
+}
+
+func executeNodes(w io.Writer, nodes []pathNode, data interface{}) error {
+	for _, node := range nodes {
+		if node.steps == nil {
+			if _, err := io.WriteString(w, node.text); err != nil {
+				return err
+			}
+			continue
+		}
+
+		values := evaluate(node.steps, data)
+		if node.isRange {
+			if len(values) == 1 {
+				if items, ok := values[0].([]interface{}); ok {
+					values = items
+				}
+			}
+			for _, value := range values {
+				if err := executeNodes(w, node.body, value); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		texts := make([]string, 0, len(values))
+		for _, value := range values {
+			texts = append(texts, pathText(value))
+		}
+		if _, err := io.WriteString(w, strings.Join(texts, " ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pathText formats a value found by an expression, writing lists and objects as JSON.
+func pathText(v interface{}) string {
+	switch v.(type) {
+	case []interface{}, map[string]interface{}:
+		return toJSON(v)
+	default:
+		return cell(v)
+	}
+}
+
+func evaluate(steps []pathStep, data interface{}) []interface{} {
+	values := []interface{}{data}
+	for _, step := range steps {
+		var next []interface{}
+		for _, value := range values {
+			next = append(next, apply(step, value)...)
+		}
+		values = next
+	}
+	return values
+}
+
+func apply(step pathStep, value interface{}) []interface{} {
+	switch step.kind {
+	case stepKey:
+		if object, ok := value.(map[string]interface{}); ok {
+			if v, ok := object[step.key]; ok {
+				return []interface{}{v}
+			}
+		}
+	case stepIndex:
+		if items, ok := value.([]interface{}); ok {
+			index := step.index
+			if index < 0 {
+				index += len(items)
+			}
+			if index >= 0 && index < len(items) {
+				return []interface{}{items[index]}
+			}
+		}
+	case stepWildcard:
+		switch v := value.(type) {
+		case []interface{}:
+			return v
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			ret := make([]interface{}, 0, len(keys))
+			for _, key := range keys {
+				ret = append(ret, v[key])
+			}
+			return ret
+		}
+	case stepDescendant:
+		var ret []interface{}
+		if object, ok := value.(map[string]interface{}); ok {
+			if v, ok := object[step.key]; ok {
+				ret = append(ret, v)
+			}
+		}
+		for _, child := range apply(pathStep{kind: stepWildcard}, value) {
+			ret = append(ret, apply(step, child)...)
+		}
+		return ret
+	}
+	return nil
+}
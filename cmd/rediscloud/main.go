@@ -0,0 +1,163 @@
+// Command rediscloud manages Redis Cloud resources from the command line, using the SDK.
+//
+// Credentials are read from the REDISCLOUD_ACCESS_KEY and REDISCLOUD_SECRET_KEY environment variables, and the API
+// can be changed with REDISCLOUD_URL. They can also come from a profile of ~/.config/rediscloud/config, selected with
+// the -profile flag or REDISCLOUD_PROFILE. Run `rediscloud help` for the list of commands.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	c := &cli{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}
+	os.Exit(c.run(ctx, os.Args[1:]))
+}
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// command is a node of the command tree. Commands either group subcommands or run.
+type command struct {
+	name string
+	// args names the positional arguments, which are all required
+	args     string
+	summary  string
+	commands []*command
+	// output is set on the commands printing a result, which then take the -output flag
+	output bool
+	// run defines the flags of the command on inv.flags, then calls inv.parse before doing its work
+	run func(ctx context.Context, inv *invocation) error
+}
+
+var root = &command{
+	name:    "rediscloud",
+	summary: "Manage Redis Cloud resources.",
+	commands: []*command{
+		subscriptionCommand,
+		databaseCommand,
+		peeringCommand,
+		aclCommand,
+		fixedCommand,
+		taskCommand,
+	},
+}
+
+func (c *cli) run(ctx context.Context, args []string) int {
+	cmd, path := root, []string{root.name}
+	for len(cmd.commands) > 0 {
+		if len(args) == 0 || isHelp(args[0]) {
+			c.usage(cmd, path)
+			if len(args) == 0 {
+				return exitUsage
+			}
+			return exitOK
+		}
+		next := cmd.find(args[0])
+		if next == nil {
+			_, _ = fmt.Fprintf(c.stderr, "unknown command %q for %q\n\n", args[0], strings.Join(path, " "))
+			c.usage(cmd, path)
+			return exitUsage
+		}
+		cmd, path, args = next, append(path, next.name), args[1:]
+	}
+
+	inv := &invocation{cli: c, cmd: cmd, args: args, flags: flag.NewFlagSet(strings.Join(path, " "), flag.ContinueOnError)}
+	inv.flags.SetOutput(c.stderr)
+	inv.profile = inv.flags.String("profile", "", "`name` of the profile of the config file to use, instead of $REDISCLOUD_PROFILE")
+	if cmd.output {
+		inv.output = inv.flags.String("output", "table", outputUsage)
+	}
+	inv.flags.Usage = func() {
+		_, _ = fmt.Fprintf(c.stderr, "Usage: %s", strings.Join(path, " "))
+		if cmd.args != "" {
+			_, _ = fmt.Fprintf(c.stderr, " %s", cmd.args)
+		}
+		if !hasFlags(inv.flags) {
+			_, _ = fmt.Fprintf(c.stderr, "\n\n%s\n", cmd.summary)
+			return
+		}
+		_, _ = fmt.Fprintf(c.stderr, " [flags]\n\n%s\n\nFlags:\n", cmd.summary)
+		inv.flags.PrintDefaults()
+	}
+
+	err := cmd.run(ctx, inv)
+	var usage *usageError
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.As(err, &usage):
+		_, _ = fmt.Fprintf(c.stderr, "%s\n\n", usage.message)
+		inv.flags.Usage()
+		return exitUsage
+	default:
+		_, _ = fmt.Fprintf(c.stderr, "Error: %s\n", err)
+		return exitError
+	}
+}
+
+func (c *cli) usage(cmd *command, path []string) {
+	_, _ = fmt.Fprintf(c.stderr, "Usage: %s <command>\n\n%s\n\nCommands:\n", strings.Join(path, " "), cmd.summary)
+	width := 0
+	for _, sub := range cmd.commands {
+		width = max(width, len(sub.name))
+	}
+	for _, sub := range cmd.commands {
+		_, _ = fmt.Fprintf(c.stderr, "  %-*s  %s\n", width, sub.name, sub.summary)
+	}
+	_, _ = fmt.Fprintf(c.stderr, "\nRun '%s <command> -h' for help on a command.\n", strings.Join(path, " "))
+}
+
+func (cmd *command) find(name string) *command {
+	for _, sub := range cmd.commands {
+		if sub.name == name {
+			return sub
+		}
+	}
+	return nil
+}
+
+func isHelp(arg string) bool {
+	return arg == "help" || arg == "-h" || arg == "-help" || arg == "--help"
+}
+
+func hasFlags(fs *flag.FlagSet) bool {
+	found := false
+	fs.VisitAll(func(*flag.Flag) { found = true })
+	return found
+}
+
+// cli holds what the commands use to interact with the outside world, so that they can be replaced in tests.
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// usageError is returned when a command is called with the wrong arguments, and is reported along with its usage.
+type usageError struct {
+	message string
+}
+
+func (e *usageError) Error() string {
+	return e.message
+}
+
+func usageErrorf(format string, args ...interface{}) error {
+	return &usageError{message: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exchange is a request the test server expects, and its response.
+type exchange struct {
+	method   string
+	path     string
+	request  string
+	response string
+}
+
+type result struct {
+	code   int
+	stdout string
+	stderr string
+}
+
+// runCLI runs the command line against a server answering the given exchanges in order.
+func runCLI(t *testing.T, stdin string, exchanges []exchange, args ...string) result {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NotEmpty(t, exchanges, "unexpected request %s %s", r.Method, r.URL.Path)
+		expected := exchanges[0]
+		exchanges = exchanges[1:]
+
+		assert.Equal(t, expected.method, r.Method)
+		assert.Equal(t, "/v1"+expected.path, r.URL.Path)
+		assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+		assert.Equal(t, "secret", r.Header.Get("X-Api-Secret-Key"))
+		if expected.request != "" {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, expected.request, string(body))
+		}
+		_, _ = w.Write([]byte(expected.response))
+	}))
+	defer s.Close()
+
+	setEnv(t, map[string]string{
+		"REDISCLOUD_ACCESS_KEY": "key",
+		"REDISCLOUD_SECRET_KEY": "secret",
+		"REDISCLOUD_URL":        s.URL + "/v1",
+	})
+	var stdout, stderr bytes.Buffer
+	c := &cli{
+		stdin:  strings.NewReader(stdin),
+		stdout: &stdout,
+		stderr: &stderr,
+	}
+	code := c.run(context.Background(), args)
+	assert.Empty(t, exchanges, "requests were not sent")
+	return result{code: code, stdout: stdout.String(), stderr: stderr.String()}
+}
+
+// setEnv replaces the variables the SDK reads, and hides the config file of the user running the tests.
+func setEnv(t *testing.T, env map[string]string) {
+	for _, name := range []string{"REDISCLOUD_ACCESS_KEY", "REDISCLOUD_SECRET_KEY", "REDISCLOUD_URL", "REDISCLOUD_PROFILE"} {
+		t.Setenv(name, env[name])
+	}
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+}
+
+func completedTask(id, resource string) []exchange {
+	return []exchange{
+		{method: http.MethodGet, path: "/tasks/" + id, response: `{"taskId": "` + id + `", "status": "processing-completed", "response": ` + resource + `}`},
+	}
+}
+
+func TestRun_usage(t *testing.T) {
+	actual := runCLI(t, "", nil)
+	assert.Equal(t, exitUsage, actual.code)
+	assert.Contains(t, actual.stderr, "Usage: rediscloud <command>")
+	assert.Contains(t, actual.stderr, "subscription  Manage Pro subscriptions.")
+
+	actual = runCLI(t, "", nil, "acl", "-h")
+	assert.Equal(t, exitOK, actual.code)
+	assert.Contains(t, actual.stderr, "Usage: rediscloud acl <command>")
+
+	actual = runCLI(t, "", nil, "database", "upgrade", "-h")
+	assert.Equal(t, exitOK, actual.code)
+	assert.Contains(t, actual.stderr, "Usage: rediscloud database upgrade SUBSCRIPTION DATABASE [flags]")
+	assert.Contains(t, actual.stderr, "-version")
+}
+
+func TestRun_usageErrors(t *testing.T) {
+	for name, test := range map[string]struct {
+		args     []string
+		expected string
+	}{
+		"unknown command":  {[]string{"subscriptions"}, `unknown command "subscriptions" for "rediscloud"`},
+		"missing argument": {[]string{"database", "get", "1"}, "rediscloud database get takes 2 argument(s): SUBSCRIPTION DATABASE"},
+		"extra argument":   {[]string{"subscription", "list", "1"}, "rediscloud subscription list takes no arguments"},
+		"invalid id":       {[]string{"subscription", "get", "one"}, `SUBSCRIPTION must be a positive number, got "one"`},
+		"unknown flag":     {[]string{"subscription", "get", "-force", "1"}, "flag provided but not defined: -force"},
+		"missing file":     {[]string{"subscription", "create"}, "-file is required"},
+		"missing version":  {[]string{"database", "upgrade", "1", "2"}, "-version is required"},
+		"unknown output":   {[]string{"subscription", "list", "-output", "xml"}, `unknown output format "xml"`},
+		"invalid template": {[]string{"subscription", "list", "-output", "template={{.name"}, "invalid template"},
+		"no output flag":   {[]string{"subscription", "delete", "1", "-output", "json"}, "flag provided but not defined: -output"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actual := runCLI(t, "", nil, test.args...)
+			assert.Equal(t, exitUsage, actual.code)
+			assert.Contains(t, actual.stderr, test.expected)
+			assert.Empty(t, actual.stdout)
+		})
+	}
+}
+
+func TestRun_unknownProfile(t *testing.T) {
+	setEnv(t, nil)
+	var stderr bytes.Buffer
+	c := &cli{stdout: io.Discard, stderr: &stderr}
+
+	assert.Equal(t, exitError, c.run(context.Background(), []string{"subscription", "list", "-profile", "prod"}))
+	assert.Contains(t, stderr.String(), `Error: failed to read profile "prod"`)
+}
+
+func TestRun_profile(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "prod-key", r.Header.Get("X-Api-Key"))
+		assert.Equal(t, "prod-secret", r.Header.Get("X-Api-Secret-Key"))
+		_, _ = w.Write([]byte(`{"subscriptions": []}`))
+	}))
+	defer s.Close()
+
+	setEnv(t, map[string]string{"REDISCLOUD_ACCESS_KEY": "key", "REDISCLOUD_SECRET_KEY": "secret"})
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "rediscloud"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "rediscloud", "config"),
+		[]byte("[prod]\napi_key = prod-key\nsecret_key = prod-secret\nurl = "+s.URL+"/v1\n"), 0o600))
+
+	var stdout bytes.Buffer
+	c := &cli{stdout: &stdout, stderr: io.Discard}
+	assert.Equal(t, exitOK, c.run(context.Background(), []string{"subscription", "list", "-profile", "prod", "-output", "json"}))
+	assert.Equal(t, "[]\n", stdout.String())
+}
+
+func TestRun_subscriptionGet(t *testing.T) {
+	actual := runCLI(t, "", []exchange{
+		{method: http.MethodGet, path: "/subscriptions/12", response: `{"id": 12, "name": "production", "status": "active"}`},
+	}, "subscription", "get", "12", "-output", "json")
+
+	assert.Equal(t, exitOK, actual.code, actual.stderr)
+	assert.JSONEq(t, `{"id": 12, "name": "production", "status": "active"}`, actual.stdout)
+}
+
+func TestRun_databaseList(t *testing.T) {
+	actual := runCLI(t, "", []exchange{
+		{method: http.MethodGet, path: "/subscriptions/12/databases", response: `{
+  "subscription": [{"subscriptionId": 12, "numberOfDatabases": 2, "databases": [{"databaseId": 1, "name": "a"}, {"databaseId": 2, "name": "b"}]}]
+}`},
+		{method: http.MethodGet, path: "/subscriptions/12/databases", response: `{"subscription": [{"subscriptionId": 12, "numberOfDatabases": 0, "databases": []}]}`},
+	}, "database", "list", "12", "-output", "json")
+
+	assert.Equal(t, exitOK, actual.code, actual.stderr)
+	assert.JSONEq(t, `[{"databaseId": 1, "name": "a"}, {"databaseId": 2, "name": "b"}]`, actual.stdout)
+}
+
+func TestRun_createFromYAML(t *testing.T) {
+	actual := runCLI(t, `
+name: reader
+role: read-only
+password: secret-password
+`, append([]exchange{
+		{method: http.MethodPost, path: "/acl/users", request: `{"name": "reader", "role": "read-only", "password": "secret-password"}`, response: `{"taskId": "task"}`},
+	}, completedTask("task", `{"resourceId": 7}`)...), "acl", "user", "create", "-file", "-", "-output", "json")
+
+	assert.Equal(t, exitOK, actual.code, actual.stderr)
+	assert.JSONEq(t, `{"id": 7}`, actual.stdout)
+}
+
+func TestRun_rejectsUnknownFields(t *testing.T) {
+	actual := runCLI(t, `{"name": "reader", "rol": "read-only"}`, nil, "acl", "user", "create", "-file", "-")
+
+	assert.Equal(t, exitError, actual.code)
+	assert.Equal(t, "Error: failed to read -: json: unknown field \"rol\"\n", actual.stderr)
+}
+
+func TestRun_updateReportsOnStderr(t *testing.T) {
+	actual := runCLI(t, `{"name": "renamed"}`, append([]exchange{
+		{method: http.MethodPut, path: "/subscriptions/12/databases/3", request: `{"name": "renamed"}`, response: `{"taskId": "task"}`},
+	}, completedTask("task", `{}`)...), "database", "update", "12", "3", "-file", "-")
+
+	assert.Equal(t, exitOK, actual.code, actual.stderr)
+	assert.Empty(t, actual.stdout)
+	assert.Equal(t, "Updated database 3 in subscription 12\n", actual.stderr)
+}
+
+func TestRun_taskWaitFailure(t *testing.T) {
+	actual := runCLI(t, "", []exchange{
+		{method: http.MethodGet, path: "/tasks/task", response: `{"taskId": "task", "status": "processing-error", "response": {"error": {"type": "INVALID", "status": "400 BAD_REQUEST", "description": "Nope"}}}`},
+	}, "task", "wait", "task", "-output", "json")
+
+	assert.Equal(t, exitError, actual.code)
+	assert.Contains(t, actual.stdout, `"status": "processing-error"`)
+	assert.Equal(t, "Error: task task failed: 400 BAD_REQUEST - INVALID: Nope\n", actual.stderr)
+}
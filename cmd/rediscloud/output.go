@@ -0,0 +1,313 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal/jsonschema"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/redis_rules"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/roles"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/users"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/fixed/plans"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/RedisLabs/rediscloud-go-api/service/tasks"
+	"gopkg.in/yaml.v3"
+)
+
+const outputUsage = "output `format`: table, json, yaml, template=TEMPLATE or jsonpath=EXPRESSION"
+
+// printer renders the result of a command.
+type printer func(w io.Writer, v interface{}) error
+
+// newPrinter returns the printer of an output format, as given to -output.
+func newPrinter(format string) (printer, error) {
+	kind, arg, _ := strings.Cut(format, "=")
+	switch kind {
+	case "table":
+		return printTable, nil
+	case "json":
+		return printJSON, nil
+	case "yaml":
+		return printYAML, nil
+	case "template", "go-template":
+		if arg == "" {
+			return nil, usageErrorf("-output %s= needs a template", kind)
+		}
+		tmpl, err := template.New("output").Funcs(template.FuncMap{"json": toJSON}).Parse(arg)
+		if err != nil {
+			return nil, usageErrorf("invalid template: %s", err)
+		}
+		return func(w io.Writer, v interface{}) error {
+			data, err := plain(v)
+			if err != nil {
+				return err
+			}
+			return tmpl.Execute(w, data)
+		}, nil
+	case "jsonpath":
+		if arg == "" {
+			return nil, usageErrorf("-output jsonpath= needs an expression")
+		}
+		path, err := parseJSONPath(arg)
+		if err != nil {
+			return nil, usageErrorf("invalid JSONPath: %s", err)
+		}
+		return func(w io.Writer, v interface{}) error {
+			data, err := plain(v)
+			if err != nil {
+				return err
+			}
+			return path.execute(w, data)
+		}, nil
+	default:
+		return nil, usageErrorf("unknown output format %q", format)
+	}
+}
+
+func printJSON(w io.Writer, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+func printYAML(w io.Writer, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	// Reading JSON as a YAML document, rather than into maps, keeps the properties in the order the API uses
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return err
+	}
+	resetStyle(&document)
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&document); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// resetStyle drops the JSON flow style and quotes from a document, so it's written in block style.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+// column is a column of a table, whose values are found at a dotted path in the JSON form of each row.
+type column struct {
+	header string
+	path   string
+}
+
+// columns are the default columns of the tables of the models the commands return, keyed by model type. Models
+// without any fall back to their top-level fields holding single values.
+var columns = map[reflect.Type][]column{
+	reflect.TypeOf(subscriptions.Subscription{}): {
+		{"ID", "id"}, {"NAME", "name"}, {"STATUS", "status"}, {"DEPLOYMENT", "deploymentType"},
+		{"PAYMENT", "paymentMethodType"}, {"DATABASES", "numberOfDatabases"},
+	},
+	reflect.TypeOf(databases.Database{}): {
+		{"ID", "databaseId"}, {"NAME", "name"}, {"STATUS", "status"}, {"PROTOCOL", "protocol"},
+		{"VERSION", "redisVersion"}, {"MEMORY (GB)", "memoryLimitInGb"}, {"ENDPOINT", "publicEndpoint"},
+	},
+	reflect.TypeOf(subscriptions.VPCPeering{}): {
+		{"ID", "vpcPeeringId"}, {"STATUS", "status"}, {"REGION", "regionName"}, {"VPC", "vpcUid"},
+		{"NETWORK", "networkName"}, {"CIDR", "vpcCidr"},
+	},
+	reflect.TypeOf(subscriptions.ActiveActiveVpcRegion{}): {
+		{"ID", "id"}, {"REGION", "region"}, {"PEERINGS", "vpcPeerings"},
+	},
+	reflect.TypeOf(users.GetUserResponse{}): {
+		{"ID", "id"}, {"NAME", "name"}, {"ROLE", "role"}, {"STATUS", "status"},
+	},
+	reflect.TypeOf(roles.GetRoleResponse{}): {
+		{"ID", "id"}, {"NAME", "name"}, {"STATUS", "status"}, {"RULES", "redisRules"},
+	},
+	reflect.TypeOf(redis_rules.GetRedisRuleResponse{}): {
+		{"ID", "id"}, {"NAME", "name"}, {"ACL", "acl"}, {"DEFAULT", "isDefault"}, {"STATUS", "status"},
+	},
+	reflect.TypeOf(plans.GetPlanResponse{}): {
+		{"ID", "id"}, {"NAME", "name"}, {"PROVIDER", "provider"}, {"REGION", "region"}, {"SIZE", "size"},
+		{"UNIT", "sizeMeasurementUnit"}, {"PRICE", "price"}, {"CURRENCY", "priceCurrency"},
+	},
+	reflect.TypeOf(tasks.Task{}): {
+		{"ID", "taskId"}, {"COMMAND", "commandType"}, {"STATUS", "status"}, {"RESOURCE", "response.resourceId"},
+		{"DESCRIPTION", "description"},
+	},
+}
+
+// printTable writes a model, or a list of them, as an aligned table with a row per model.
+func printTable(w io.Writer, v interface{}) error {
+	t := reflect.TypeOf(v)
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		t = t.Elem()
+	}
+
+	data, err := plain(v)
+	if err != nil {
+		return err
+	}
+	rows, ok := data.([]interface{})
+	if !ok {
+		rows = []interface{}{data}
+	}
+
+	cols := columnsOf(t)
+	if len(cols) == 0 {
+		// Lists of plain values
+		cols = []column{{"VALUE", ""}}
+	}
+
+	var out bytes.Buffer
+	tw := tabwriter.NewWriter(&out, 0, 0, 3, ' ', 0)
+	headers := make([]string, 0, len(cols))
+	for _, col := range cols {
+		headers = append(headers, col.header)
+	}
+	_, _ = fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		cells := make([]string, 0, len(cols))
+		for _, col := range cols {
+			cells = append(cells, cell(lookup(row, col.path)))
+		}
+		_, _ = fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	// Empty cells at the end of a row are still padded
+	lines := strings.Split(out.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	_, err = io.WriteString(w, strings.Join(lines, "\n"))
+	return err
+}
+
+func columnsOf(t reflect.Type) []column {
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+	if cols, ok := columns[t]; ok {
+		return cols
+	}
+
+	var cols []column
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, ok := jsonschema.FieldName(field)
+		if !ok || name == "" || !isSingleValue(field.Type) {
+			continue
+		}
+		cols = append(cols, column{header: header(name), path: name})
+	}
+	return cols
+}
+
+func isSingleValue(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == reflect.TypeOf(time.Time{}) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// header turns a JSON property name into a column header, e.g. numberOfDatabases into NUMBER OF DATABASES.
+func header(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' && !(name[i-1] >= 'A' && name[i-1] <= 'Z') {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToUpper(b.String())
+}
+
+// lookup follows a dotted path through the JSON form of a model. The empty path is the value itself.
+func lookup(v interface{}, path string) interface{} {
+	if path == "" {
+		return v
+	}
+	for _, key := range strings.Split(path, ".") {
+		object, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = object[key]
+	}
+	return v
+}
+
+// cell formats a value for a table: lists of values are joined by commas and objects are written as JSON.
+func cell(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if _, ok := item.(map[string]interface{}); ok {
+				return toJSON(v)
+			}
+			values = append(values, cell(item))
+		}
+		return strings.Join(values, ",")
+	case map[string]interface{}:
+		return toJSON(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// plain returns the JSON form of a model, made of maps, slices and values, so that templates and paths refer to the
+// properties by their names in the API.
+func plain(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var ret interface{}
+	if err := decoder.Decode(&ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func toJSON(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%%!(%s)", err)
+	}
+	return string(data)
+}
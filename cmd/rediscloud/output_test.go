@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func render(t *testing.T, format string, v interface{}) string {
+	p, err := newPrinter(format)
+	require.NoError(t, err)
+	var out bytes.Buffer
+	require.NoError(t, p(&out, v))
+	return out.String()
+}
+
+var listed = []*subscriptions.Subscription{
+	{
+		ID:                redis.Int(1),
+		Name:              redis.String("production"),
+		Status:            redis.Ptr(subscriptions.SubscriptionStatusActive),
+		NumberOfDatabases: redis.Int(3),
+		CloudDetails:      []*subscriptions.CloudDetail{{Provider: redis.String("AWS")}},
+	},
+	{
+		ID:     redis.Int(20),
+		Name:   redis.String("staging"),
+		Status: redis.Ptr(subscriptions.SubscriptionStatusPending),
+	},
+}
+
+func TestPrintTable_defaultColumns(t *testing.T) {
+	assert.Equal(t, `ID   NAME         STATUS    DEPLOYMENT   PAYMENT   DATABASES
+1    production   active                           3
+20   staging      pending
+`, render(t, "table", listed))
+}
+
+func TestPrintTable_singleModel(t *testing.T) {
+	assert.Equal(t, `ID   NAME      STATUS    DEPLOYMENT   PAYMENT   DATABASES
+20   staging   pending
+`, render(t, "table", listed[1]))
+}
+
+func TestPrintTable_fallsBackToSingleValueFields(t *testing.T) {
+	type model struct {
+		ID        *int       `json:"id,omitempty"`
+		CreatedAt *time.Time `json:"createdAt,omitempty"`
+		Tags      []string   `json:"tags,omitempty"`
+		Nested    *model     `json:"nested,omitempty"`
+		ignored   string
+	}
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	assert.Equal(t, `ID   CREATED AT
+4    2024-01-02T03:04:05Z
+`, render(t, "table", []model{{ID: redis.Int(4), CreatedAt: &at, Tags: []string{"a"}, ignored: "x"}}))
+}
+
+func TestPrintTable_cells(t *testing.T) {
+	assert.Equal(t, "", cell(nil))
+	assert.Equal(t, "a,b", cell([]interface{}{"a", "b"}))
+	assert.Equal(t, `[{"a":1}]`, cell([]interface{}{map[string]interface{}{"a": 1}}))
+	assert.Equal(t, `{"a":"b"}`, cell(map[string]interface{}{"a": "b"}))
+	assert.Equal(t, "true", cell(true))
+}
+
+func TestPrintYAML_keepsOrder(t *testing.T) {
+	assert.Equal(t, `- id: 1
+  name: production
+  status: active
+  numberOfDatabases: 3
+  cloudDetails:
+    - provider: AWS
+- id: 20
+  name: staging
+  status: pending
+`, render(t, "yaml", listed))
+
+	// Strings which would read as other types stay strings
+	assert.Equal(t, "name: \"123\"\nstatus: \"true\"\n", render(t, "yaml", map[string]string{"name": "123", "status": "true"}))
+}
+
+func TestPrintTemplate(t *testing.T) {
+	assert.Equal(t, "production=active\nstaging=pending\n",
+		render(t, `template={{range .}}{{.name}}={{.status}}{{"\n"}}{{end}}`, listed))
+	assert.Equal(t, `[{"provider":"AWS"}]`, render(t, `go-template={{json (index . 0).cloudDetails}}`, listed))
+}
+
+func TestPrintJSONPath(t *testing.T) {
+	assert.Equal(t, "1 20", render(t, "jsonpath={[*].id}", listed))
+	assert.Equal(t, "1\tproduction\n20\tstaging\n", render(t, `jsonpath={range [*]}{.id}{"\t"}{.name}{"\n"}{end}`, listed))
+	assert.Equal(t, "AWS", render(t, "jsonpath={..provider}", listed))
+	assert.Equal(t, "staging", render(t, "jsonpath={$[-1].name}", listed))
+	assert.Equal(t, `[{"provider":"AWS"}]`, render(t, "jsonpath={[0]['cloudDetails']}", listed))
+	assert.Equal(t, "name: production", render(t, "jsonpath=name: {[0].name}{[0].missing}", listed))
+}
+
+func TestNewPrinter_invalid(t *testing.T) {
+	for format, expected := range map[string]string{
+		"csv":                  `unknown output format "csv"`,
+		"template=":            "-output template= needs a template",
+		"jsonpath=":            "-output jsonpath= needs an expression",
+		"jsonpath={.name":      `invalid JSONPath: unclosed expression "{.name"`,
+		"jsonpath={range .a}":  "invalid JSONPath: {range} without {end}",
+		"jsonpath={end}":       "invalid JSONPath: {end} without {range}",
+		"jsonpath={.a[x]}":     `invalid JSONPath: invalid index [x] in ".a[x]"`,
+		"jsonpath={.a.}":       `invalid JSONPath: missing key after . in ".a."`,
+		"template={{.name}":    "invalid template: template: output:1: bad character U+007D '}'",
+		"jsonpath={'unclosed}": `invalid JSONPath: unclosed expression "{'unclosed}"`,
+	} {
+		t.Run(format, func(t *testing.T) {
+			_, err := newPrinter(format)
+			assert.EqualError(t, err, expected)
+		})
+	}
+}
+
+func TestRun_outputFormats(t *testing.T) {
+	exchanges := func() []exchange {
+		return []exchange{
+			{method: http.MethodGet, path: "/subscriptions", response: `{"subscriptions": [{"id": 1, "name": "production", "status": "active"}]}`},
+		}
+	}
+
+	actual := runCLI(t, "", exchanges(), "subscription", "list")
+	assert.Equal(t, exitOK, actual.code, actual.stderr)
+	assert.Equal(t, "ID   NAME         STATUS   DEPLOYMENT   PAYMENT   DATABASES\n1    production   active\n", actual.stdout)
+
+	actual = runCLI(t, "", exchanges(), "subscription", "list", "-output", "jsonpath={[0].name}")
+	assert.Equal(t, exitOK, actual.code, actual.stderr)
+	assert.Equal(t, "production\n", actual.stdout)
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+)
+
+var peeringCommand = &command{
+	name:    "peering",
+	summary: "Manage the VPC peerings of Pro subscriptions.",
+	commands: []*command{
+		{
+			name:    "list",
+			args:    "SUBSCRIPTION",
+			summary: "List the VPC peerings of a subscription.",
+			output:  true,
+			run: func(ctx context.Context, inv *invocation) error {
+				activeActive := activeActiveFlag(inv.flags)
+				args, err := inv.parse()
+				if err != nil {
+					return err
+				}
+				subscription, err := inv.id(args, 0)
+				if err != nil {
+					return err
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				if *activeActive {
+					regions, err := client.Subscription.ListActiveActiveVPCPeering(ctx, subscription)
+					if err != nil {
+						return err
+					}
+					return inv.print(regions)
+				}
+				peerings, err := client.Subscription.ListVPCPeering(ctx, subscription)
+				if err != nil {
+					return err
+				}
+				return inv.print(peerings)
+			},
+		},
+		{
+			name:    "create",
+			args:    "SUBSCRIPTION",
+			summary: "Request a VPC peering, and wait for it to be accepted on the cloud provider's side.",
+			output:  true,
+			run: func(ctx context.Context, inv *invocation) error {
+				activeActive := activeActiveFlag(inv.flags)
+				file := inv.fileFlag()
+				args, err := inv.parse()
+				if err != nil {
+					return err
+				}
+				subscription, err := inv.id(args, 0)
+				if err != nil {
+					return err
+				}
+
+				var id int
+				if *activeActive {
+					var request subscriptions.CreateActiveActiveVPCPeering
+					if err := inv.read(*file, &request); err != nil {
+						return err
+					}
+					client, err := inv.client()
+					if err != nil {
+						return err
+					}
+					id, err = client.Subscription.CreateActiveActiveVPCPeering(ctx, subscription, request)
+					if err != nil {
+						return err
+					}
+				} else {
+					var request subscriptions.CreateVPCPeering
+					if err := inv.read(*file, &request); err != nil {
+						return err
+					}
+					client, err := inv.client()
+					if err != nil {
+						return err
+					}
+					id, err = client.Subscription.CreateVPCPeering(ctx, subscription, request)
+					if err != nil {
+						return err
+					}
+				}
+				return inv.print(created{ID: id})
+			},
+		},
+		{
+			name:    "delete",
+			args:    "SUBSCRIPTION PEERING",
+			summary: "Delete a VPC peering.",
+			run: func(ctx context.Context, inv *invocation) error {
+				activeActive := activeActiveFlag(inv.flags)
+				args, err := inv.parse()
+				if err != nil {
+					return err
+				}
+				subscription, err := inv.id(args, 0)
+				if err != nil {
+					return err
+				}
+				peering, err := inv.id(args, 1)
+				if err != nil {
+					return err
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				if *activeActive {
+					err = client.Subscription.DeleteActiveActiveVPCPeering(ctx, subscription, peering)
+				} else {
+					err = client.Subscription.DeleteVPCPeering(ctx, subscription, peering)
+				}
+				if err != nil {
+					return err
+				}
+				return inv.done("Deleted VPC peering %d of subscription %d", peering, subscription)
+			},
+		},
+	},
+}
+
+func activeActiveFlag(fs *flag.FlagSet) *bool {
+	return fs.Bool("active-active", false, "the subscription is Active-Active")
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+)
+
+var subscriptionCommand = &command{
+	name:    "subscription",
+	summary: "Manage Pro subscriptions.",
+	commands: []*command{
+		{
+			name:    "list",
+			summary: "List the subscriptions of the account.",
+			output:  true,
+			run: func(ctx context.Context, inv *invocation) error {
+				if _, err := inv.parse(); err != nil {
+					return err
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				list, err := client.Subscription.List(ctx)
+				if err != nil {
+					return err
+				}
+				return inv.print(list)
+			},
+		},
+		{
+			name:    "get",
+			args:    "SUBSCRIPTION",
+			summary: "Show a subscription.",
+			output:  true,
+			run: func(ctx context.Context, inv *invocation) error {
+				args, err := inv.parse()
+				if err != nil {
+					return err
+				}
+				id, err := inv.id(args, 0)
+				if err != nil {
+					return err
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				subscription, err := client.Subscription.Get(ctx, id)
+				if err != nil {
+					return err
+				}
+				return inv.print(subscription)
+			},
+		},
+		{
+			name:    "create",
+			summary: "Create a subscription, and wait for it to be active.",
+			output:  true,
+			run: func(ctx context.Context, inv *invocation) error {
+				file := inv.fileFlag()
+				if _, err := inv.parse(); err != nil {
+					return err
+				}
+				var request subscriptions.CreateSubscription
+				if err := inv.read(*file, &request); err != nil {
+					return err
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				id, err := client.Subscription.Create(ctx, request)
+				if err != nil {
+					return err
+				}
+				return inv.print(created{ID: id})
+			},
+		},
+		{
+			name:    "delete",
+			args:    "SUBSCRIPTION",
+			summary: "Delete a subscription, which must not have any database left.",
+			run: func(ctx context.Context, inv *invocation) error {
+				args, err := inv.parse()
+				if err != nil {
+					return err
+				}
+				id, err := inv.id(args, 0)
+				if err != nil {
+					return err
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				if err := client.Subscription.Delete(ctx, id); err != nil {
+					return err
+				}
+				return inv.done("Deleted subscription %d", id)
+			},
+		},
+	},
+}
+
+// created is printed by the commands creating a resource.
+type created struct {
+	ID int `json:"id"`
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+)
+
+var taskCommand = &command{
+	name:    "task",
+	summary: "Follow the asynchronous tasks started by changes.",
+	commands: []*command{
+		{
+			name:    "get",
+			args:    "TASK",
+			summary: "Show the current state of a task.",
+			output:  true,
+			run: func(ctx context.Context, inv *invocation) error {
+				args, err := inv.parse()
+				if err != nil {
+					return err
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				task, err := client.Tasks.Get(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return inv.print(task)
+			},
+		},
+		{
+			name:    "wait",
+			args:    "TASK",
+			summary: "Wait for a task to be processed, and show it. Fails if the task does.",
+			output:  true,
+			run: func(ctx context.Context, inv *invocation) error {
+				args, err := inv.parse()
+				if err != nil {
+					return err
+				}
+				client, err := inv.client()
+				if err != nil {
+					return err
+				}
+				task, err := client.Tasks.Wait(ctx, args[0])
+				if task != nil {
+					if err := inv.print(task); err != nil {
+						return err
+					}
+				}
+				return err
+			},
+		},
+	},
+}
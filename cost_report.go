@@ -0,0 +1,289 @@
+package rediscloud_api
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/RedisLabs/rediscloud-go-api/service/tags"
+)
+
+// Unallocated labels, in CSV reports, the costs which no tag value could be found for.
+const Unallocated = "(unallocated)"
+
+// CostReport is what the account costs, grouped by the value of a tag of its databases and subscriptions.
+type CostReport struct {
+	Tag    string       `json:"tag"`
+	Groups []*CostGroup `json:"groups"`
+}
+
+// CostGroup is what the databases and subscriptions sharing a tag value cost, or the costs no value was found for.
+type CostGroup struct {
+	Value       string        `json:"value,omitempty"`
+	Unallocated bool          `json:"unallocated,omitempty"`
+	Costs       pricing.Costs `json:"costs"`
+}
+
+// CostReportBuilder builds a CostReport of the account:
+//
+//	report, err := rediscloud_api.NewCostReport(client, "team").Build(ctx)
+//	err = report.WriteCSV(os.Stdout)
+//
+// The pricing of each Pro subscription is allocated line by line: lines for a database go to the value of the tag on
+// that database, or else on its subscription's resource tags, and other lines to the value on the subscription. A
+// Fixed subscription costs the price of its plan, which goes to the value shared by the tags of all of its databases.
+// Whatever no value is found for is grouped as unallocated, so the groups add up to the whole account.
+//
+// The pricing API only covers Pro subscriptions, so Fixed subscriptions are priced from the plan catalogue
+// (FixedPlans.List) instead, at the current list price of their plan. A plan which isn't priced per hour or per month
+// can't be added up with the rest, and fails the report.
+type CostReportBuilder struct {
+	client        *Client
+	tag           string
+	subscriptions map[int]bool
+	fixed         bool
+}
+
+// NewCostReport starts building a report of the costs of the account grouped by the value of the tag with the given
+// key.
+func NewCostReport(client *Client, tag string) *CostReportBuilder {
+	return &CostReportBuilder{client: client, tag: tag, fixed: true}
+}
+
+// Subscriptions limits the report to the Pro and Fixed subscriptions with the given IDs - will default to all of them.
+func (b *CostReportBuilder) Subscriptions(ids ...int) *CostReportBuilder {
+	b.subscriptions = map[int]bool{}
+	for _, id := range ids {
+		b.subscriptions[id] = true
+	}
+	return b
+}
+
+// Fixed sets whether Fixed subscriptions are reported - will default to true.
+func (b *CostReportBuilder) Fixed(include bool) *CostReportBuilder {
+	b.fixed = include
+	return b
+}
+
+// Build walks the subscriptions of the account, with their pricing and tags, and returns the report.
+func (b *CostReportBuilder) Build(ctx context.Context) (*CostReport, error) {
+	lines := map[string][]*pricing.Pricing{}
+
+	pro, err := b.client.Subscription.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, subscription := range pro {
+		if !b.includes(subscription.ID) {
+			continue
+		}
+		if err := b.allocatePro(ctx, subscription, lines); err != nil {
+			return nil, err
+		}
+	}
+
+	if b.fixed {
+		if err := b.allocateFixed(ctx, lines); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &CostReport{Tag: b.tag}
+	for value, group := range lines {
+		report.Groups = append(report.Groups, &CostGroup{Value: value, Unallocated: value == "", Costs: pricing.Total(group)})
+	}
+	sort.Slice(report.Groups, func(i, j int) bool {
+		// The unallocated costs come last
+		if report.Groups[i].Unallocated != report.Groups[j].Unallocated {
+			return report.Groups[j].Unallocated
+		}
+		return report.Groups[i].Value < report.Groups[j].Value
+	})
+	return report, nil
+}
+
+func (b *CostReportBuilder) includes(id *int) bool {
+	return b.subscriptions == nil || b.subscriptions[redis.IntValue(id)]
+}
+
+// allocatePro adds the pricing lines of a Pro subscription to the groups of their tag values.
+func (b *CostReportBuilder) allocatePro(ctx context.Context, subscription *subscriptions.Subscription, lines map[string][]*pricing.Pricing) error {
+	id := redis.IntValue(subscription.ID)
+
+	fallback := ""
+	for _, cloud := range subscription.CloudDetails {
+		for _, tag := range cloud.ResourceTags {
+			if fallback == "" && redis.StringValue(tag.Key) == b.tag {
+				fallback = redis.StringValue(tag.Value)
+			}
+		}
+	}
+
+	subscriptionPricing, err := b.client.Pricing.List(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var values map[string]string
+	for _, line := range subscriptionPricing {
+		value := fallback
+		if name := redis.StringValue(line.DatabaseName); name != "" {
+			if values == nil {
+				if values, err = b.databaseValues(ctx, id); err != nil {
+					return err
+				}
+			}
+			if tagged, ok := values[name]; ok && tagged != "" {
+				value = tagged
+			}
+		}
+		lines[value] = append(lines[value], line)
+	}
+	return nil
+}
+
+// databaseValues returns the value of the tag on each database of a Pro subscription, by database name.
+func (b *CostReportBuilder) databaseValues(ctx context.Context, subscription int) (map[string]string, error) {
+	values := map[string]string{}
+	list := b.client.Database.List(ctx, subscription)
+	for list.Next() {
+		db := list.Value()
+		databaseTags, err := b.client.Tags.Get(ctx, subscription, redis.IntValue(db.ID))
+		if err != nil {
+			return nil, err
+		}
+		values[redis.StringValue(db.Name)] = b.valueOf(databaseTags)
+	}
+	if err := list.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// allocateFixed adds the price of the plan of each Fixed subscription to the group of the value its databases share.
+func (b *CostReportBuilder) allocateFixed(ctx context.Context, lines map[string][]*pricing.Pricing) error {
+	fixed, err := b.client.FixedSubscriptions.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var plans map[int]*pricing.Pricing
+	for _, subscription := range fixed {
+		if !b.includes(subscription.ID) {
+			continue
+		}
+		if plans == nil {
+			if plans, err = b.planPricing(ctx); err != nil {
+				return err
+			}
+		}
+		plan, ok := plans[redis.IntValue(subscription.PlanId)]
+		if !ok {
+			return fmt.Errorf("failed to find plan %d of fixed subscription %d", redis.IntValue(subscription.PlanId), redis.IntValue(subscription.ID))
+		}
+		if !pricing.Recurring(plan) {
+			return fmt.Errorf("plan %d of fixed subscription %d is priced per %q, which isn't an hour or a month", redis.IntValue(subscription.PlanId), redis.IntValue(subscription.ID), redis.StringValue(plan.PricePeriod))
+		}
+
+		value, err := b.fixedValue(ctx, redis.IntValue(subscription.ID))
+		if err != nil {
+			return err
+		}
+		lines[value] = append(lines[value], plan)
+	}
+	return nil
+}
+
+// planPricing returns the price of each Fixed plan as a pricing line, by plan ID.
+func (b *CostReportBuilder) planPricing(ctx context.Context) (map[int]*pricing.Pricing, error) {
+	list, err := b.client.FixedPlans.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := map[int]*pricing.Pricing{}
+	for _, plan := range list {
+		plans[redis.IntValue(plan.ID)] = &pricing.Pricing{
+			Type:          redis.String("Plan"),
+			TypeDetails:   plan.Name,
+			Quantity:      redis.Int(1),
+			PricePerUnit:  redis.Float64(float64(redis.IntValue(plan.Price))),
+			PriceCurrency: plan.PriceCurrency,
+			PricePeriod:   plan.PricePeriod,
+			Region:        plan.Region,
+		}
+	}
+	return plans, nil
+}
+
+// fixedValue returns the value of the tag shared by all the databases of a Fixed subscription, or "" when they don't
+// share one.
+func (b *CostReportBuilder) fixedValue(ctx context.Context, subscription int) (string, error) {
+	value, first := "", true
+	list := b.client.FixedDatabases.List(ctx, subscription)
+	for list.Next() {
+		databaseTags, err := b.client.Tags.GetFixed(ctx, subscription, redis.IntValue(list.Value().DatabaseId))
+		if err != nil {
+			return "", err
+		}
+		tagged := b.valueOf(databaseTags)
+		if first {
+			value, first = tagged, false
+		} else if tagged != value {
+			value = ""
+		}
+	}
+	if err := list.Err(); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+func (b *CostReportBuilder) valueOf(all *tags.AllTags) string {
+	if all == nil || all.Tags == nil {
+		return ""
+	}
+	for _, tag := range *all.Tags {
+		if redis.StringValue(tag.Key) == b.tag {
+			return redis.StringValue(tag.Value)
+		}
+	}
+	return ""
+}
+
+// WriteJSON writes the report as a JSON document.
+func (r *CostReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteCSV writes the report as CSV, with a row per tag value and currency. Its columns are the tag key, currency,
+// hourly and monthly cost, and the unallocated costs are labelled Unallocated.
+func (r *CostReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{r.Tag, "currency", "hourly", "monthly"}); err != nil {
+		return err
+	}
+	for _, group := range r.Groups {
+		value := group.Value
+		if group.Unallocated {
+			value = Unallocated
+		}
+		for _, cost := range group.Costs {
+			row := []string{value, cost.Currency, strconv.FormatFloat(cost.Hourly, 'f', 4, 64), strconv.FormatFloat(cost.Monthly, 'f', 2, 64)}
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,159 @@
+package rediscloud_api
+
+import (
+	"bytes"
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func costReportServer(t *testing.T) *httptest.Server {
+	page := func(offset string) map[string][]string {
+		return map[string][]string{"limit": {"100"}, "offset": {offset}}
+	}
+
+	return httptest.NewServer(testServer("key", "secret",
+		getRequest(t, "/subscriptions", `{"subscriptions": [
+		  {"id": 1, "name": "tagged", "cloudDetails": [{"provider": "AWS", "resourceTags": [{"key": "team", "value": "platform"}]}]},
+		  {"id": 2, "name": "untagged"}
+		]}`),
+		getRequest(t, "/subscriptions/1/pricing", `{"pricing": [
+		  {"databaseName": "cache", "type": "Shards", "quantity": 2, "pricePerUnit": 0.5, "priceCurrency": "USD", "pricePeriod": "hour"},
+		  {"databaseName": "sessions", "type": "Shards", "quantity": 1, "pricePerUnit": 0.25, "priceCurrency": "USD", "pricePeriod": "hour"},
+		  {"type": "EBS Volume", "quantity": 1, "pricePerUnit": 0.1, "priceCurrency": "USD", "pricePeriod": "hour"}
+		]}`),
+		getRequestWithQuery(t, "/subscriptions/1/databases", page("0"), `{"subscription": [{"subscriptionId": 1, "databases": [
+		  {"databaseId": 10, "name": "cache"},
+		  {"databaseId": 11, "name": "sessions"}
+		]}]}`),
+		getRequest(t, "/subscriptions/1/databases/10/tags", `{"tags": [{"key": "env", "value": "prod"}, {"key": "team", "value": "search"}]}`),
+		// Without the tag, the database falls back to its subscription's
+		getRequest(t, "/subscriptions/1/databases/11/tags", `{"tags": []}`),
+		getRequestWithQueryAndStatus(t, "/subscriptions/1/databases", page("100"), 404, ""),
+		getRequest(t, "/subscriptions/2/pricing", `{"pricing": [
+		  {"type": "Shards", "quantity": 1, "pricePerUnit": 0.2, "priceCurrency": "USD", "pricePeriod": "hour"}
+		]}`),
+		getRequest(t, "/fixed/subscriptions", `{"subscriptions": [{"id": 3, "name": "fixed", "planId": 99}]}`),
+		getRequest(t, "/fixed/plans", `{"plans": [{"id": 99, "name": "Standard 250MB", "price": 7, "priceCurrency": "USD", "pricePeriod": "Month"}]}`),
+		getRequestWithQuery(t, "/fixed/subscriptions/3/databases", page("0"), `{"subscription": {"subscriptionId": 3, "databases": [{"databaseId": 30, "name": "queue"}]}}`),
+		getRequest(t, "/fixed/subscriptions/3/databases/30/tags", `{"tags": [{"key": "team", "value": "search"}]}`),
+		getRequestWithQuery(t, "/fixed/subscriptions/3/databases", page("100"), `{"subscription": {"subscriptionId": 3, "databases": []}}`),
+	))
+}
+
+func TestCostReport(t *testing.T) {
+	s := costReportServer(t)
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	report, err := NewCostReport(subject, "team").Build(context.TODO())
+	require.NoError(t, err)
+
+	assert.Equal(t, "team", report.Tag)
+	require.Len(t, report.Groups, 3)
+
+	assert.Equal(t, "platform", report.Groups[0].Value)
+	assertCost(t, pricing.Cost{Currency: "USD", Hourly: 0.35, Monthly: 255.5}, report.Groups[0].Costs)
+	assert.Equal(t, "search", report.Groups[1].Value)
+	assertCost(t, pricing.Cost{Currency: "USD", Hourly: 1 + 7.0/730, Monthly: 737}, report.Groups[1].Costs)
+	assert.True(t, report.Groups[2].Unallocated)
+	assertCost(t, pricing.Cost{Currency: "USD", Hourly: 0.2, Monthly: 146}, report.Groups[2].Costs)
+
+	var csv bytes.Buffer
+	require.NoError(t, report.WriteCSV(&csv))
+	assert.Equal(t, `team,currency,hourly,monthly
+platform,USD,0.3500,255.50
+search,USD,1.0096,737.00
+(unallocated),USD,0.2000,146.00
+`, csv.String())
+
+	var out bytes.Buffer
+	require.NoError(t, report.WriteJSON(&out))
+	assert.JSONEq(t, `{
+	  "tag": "team",
+	  "groups": [
+	    {"value": "platform", "costs": [{"currency": "USD", "hourly": 0.35, "monthly": 255.5}]},
+	    {"value": "search", "costs": [{"currency": "USD", "hourly": 1.0095890410958903, "monthly": 737}]},
+	    {"unallocated": true, "costs": [{"currency": "USD", "hourly": 0.2, "monthly": 146}]}
+	  ]
+	}`, out.String())
+}
+
+func TestCostReport_subscriptions(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		getRequest(t, "/subscriptions", `{"subscriptions": [{"id": 1}, {"id": 2}]}`),
+		getRequest(t, "/subscriptions/2/pricing", `{"pricing": [
+		  {"type": "Shards", "quantity": 1, "pricePerUnit": 0.2, "priceCurrency": "USD", "pricePeriod": "hour"}
+		]}`),
+	))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	report, err := NewCostReport(subject, "team").Subscriptions(2).Fixed(false).Build(context.TODO())
+	require.NoError(t, err)
+
+	require.Len(t, report.Groups, 1)
+	assert.True(t, report.Groups[0].Unallocated)
+}
+
+// fixedCostReportServer serves an account with a single Fixed subscription, on a plan with the given period, whose two
+// databases are tagged with the given values.
+func fixedCostReportServer(t *testing.T, period string, first string, second string) *httptest.Server {
+	page := func(offset string) map[string][]string {
+		return map[string][]string{"limit": {"100"}, "offset": {offset}}
+	}
+
+	return httptest.NewServer(testServer("key", "secret",
+		getRequest(t, "/subscriptions", `{"subscriptions": []}`),
+		getRequest(t, "/fixed/subscriptions", `{"subscriptions": [{"id": 3, "name": "fixed", "planId": 99}]}`),
+		getRequest(t, "/fixed/plans", `{"plans": [{"id": 99, "name": "Standard 250MB", "price": 7, "priceCurrency": "USD", "pricePeriod": "`+period+`"}]}`),
+		getRequestWithQuery(t, "/fixed/subscriptions/3/databases", page("0"), `{"subscription": {"subscriptionId": 3, "databases": [
+		  {"databaseId": 30, "name": "queue"},
+		  {"databaseId": 31, "name": "cache"}
+		]}}`),
+		getRequest(t, "/fixed/subscriptions/3/databases/30/tags", `{"tags": [{"key": "team", "value": "`+first+`"}]}`),
+		getRequest(t, "/fixed/subscriptions/3/databases/31/tags", `{"tags": [{"key": "team", "value": "`+second+`"}]}`),
+		getRequestWithQuery(t, "/fixed/subscriptions/3/databases", page("100"), `{"subscription": {"subscriptionId": 3, "databases": []}}`),
+	))
+}
+
+func TestCostReport_fixedSubscriptionWithMixedTagsIsUnallocated(t *testing.T) {
+	s := fixedCostReportServer(t, "Month", "search", "platform")
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	report, err := NewCostReport(subject, "team").Build(context.TODO())
+	require.NoError(t, err)
+
+	require.Len(t, report.Groups, 1)
+	assert.True(t, report.Groups[0].Unallocated)
+	assertCost(t, pricing.Cost{Currency: "USD", Hourly: 7.0 / 730, Monthly: 7}, report.Groups[0].Costs)
+}
+
+func TestCostReport_fixedPlanWithUnknownPeriod(t *testing.T) {
+	s := fixedCostReportServer(t, "Year", "search", "search")
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	_, err = NewCostReport(subject, "team").Build(context.TODO())
+	assert.EqualError(t, err, `plan 99 of fixed subscription 3 is priced per "Year", which isn't an hour or a month`)
+}
+
+func assertCost(t *testing.T, expected pricing.Cost, actual pricing.Costs) {
+	require.Len(t, actual, 1)
+	assert.Equal(t, expected.Currency, actual[0].Currency)
+	assert.InDelta(t, expected.Hourly, actual[0].Hourly, 1e-9)
+	assert.InDelta(t, expected.Monthly, actual[0].Monthly, 1e-9)
+}
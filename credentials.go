@@ -0,0 +1,199 @@
+package rediscloud_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Credentials are the keys requests to the API are authenticated with.
+type Credentials struct {
+	APIKey    string
+	SecretKey string
+	// Expires is when the credentials should be retrieved again, or zero when they're kept until the API refuses them.
+	Expires time.Time
+}
+
+// CredentialsProvider supplies the credentials of the client. Credentials are retrieved before the first request and
+// kept until they expire, or the API answers with 401 Unauthorized: they're then retrieved again and, when they
+// have changed, the request is sent once more. This lets keys be rotated without recreating the client.
+type CredentialsProvider interface {
+	Retrieve(ctx context.Context) (Credentials, error)
+}
+
+// CredentialsProviderFunc adapts a function to a CredentialsProvider.
+type CredentialsProviderFunc func(ctx context.Context) (Credentials, error)
+
+func (f CredentialsProviderFunc) Retrieve(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// ErrNoCredentials is returned, possibly wrapped, by providers whose source holds no credentials, such as unset
+// environment variables or missing files, so that ChainCredentials moves on to the next provider.
+var ErrNoCredentials = errors.New("no credentials found")
+
+// AuthProvider sets the provider of the credentials, for credentials which may change while the client is used - will
+// otherwise default to the credentials set with Auth, the environment variables or a profile of the config file.
+func AuthProvider(provider CredentialsProvider) Option {
+	return func(options *Options) {
+		options.credentials = provider
+	}
+}
+
+// StaticCredentials always provides the same credentials.
+func StaticCredentials(apiKey, secretKey string) CredentialsProvider {
+	return CredentialsProviderFunc(func(context.Context) (Credentials, error) {
+		return Credentials{APIKey: apiKey, SecretKey: secretKey}, nil
+	})
+}
+
+// EnvCredentials provides the credentials in the `REDISCLOUD_ACCESS_KEY` and `REDISCLOUD_SECRET_KEY` environment
+// variables, as they are when retrieved.
+func EnvCredentials() CredentialsProvider {
+	return CredentialsProviderFunc(func(context.Context) (Credentials, error) {
+		apiKey, secretKey := os.Getenv(AccessKeyEnvVar), os.Getenv(SecretKeyEnvVar)
+		if apiKey == "" || secretKey == "" {
+			return Credentials{}, fmt.Errorf("%s and %s: %w", AccessKeyEnvVar, SecretKeyEnvVar, ErrNoCredentials)
+		}
+		return Credentials{APIKey: apiKey, SecretKey: secretKey}, nil
+	})
+}
+
+// FileCredentials provides the credentials held in two files, one for each key, as mounted from secret managers.
+// Surrounding whitespace is ignored. The files are read again when the API refuses the credentials, so they can be
+// rotated in place.
+func FileCredentials(apiKeyFile, secretKeyFile string) CredentialsProvider {
+	return CredentialsProviderFunc(func(context.Context) (Credentials, error) {
+		apiKey, err := readKeyFile(apiKeyFile)
+		if err != nil {
+			return Credentials{}, err
+		}
+		secretKey, err := readKeyFile(secretKeyFile)
+		if err != nil {
+			return Credentials{}, err
+		}
+		return Credentials{APIKey: apiKey, SecretKey: secretKey}, nil
+	})
+}
+
+func readKeyFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("%s: %w", path, ErrNoCredentials)
+	}
+	if err != nil {
+		return "", err
+	}
+	key := strings.TrimSpace(string(data))
+	if key == "" {
+		return "", fmt.Errorf("%s is empty: %w", path, ErrNoCredentials)
+	}
+	return key, nil
+}
+
+// CommandCredentials provides the credentials written to stdout by a command, as a JSON object such as
+// `{"apiKey": "...", "secretKey": "...", "expires": "2024-01-02T15:04:05Z"}`, where expires is optional.
+func CommandCredentials(name string, args ...string) CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		var stdout, stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, name, args...)
+		cmd.Stdout, cmd.Stderr = &stdout, &stderr
+		if err := cmd.Run(); err != nil {
+			return Credentials{}, fmt.Errorf("credentials command %s failed: %w: %s", name, err, strings.TrimSpace(stderr.String()))
+		}
+
+		var output struct {
+			APIKey    string    `json:"apiKey"`
+			SecretKey string    `json:"secretKey"`
+			Expires   time.Time `json:"expires"`
+		}
+		if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+			return Credentials{}, fmt.Errorf("credentials command %s returned invalid JSON: %w", name, err)
+		}
+		if output.APIKey == "" || output.SecretKey == "" {
+			return Credentials{}, fmt.Errorf("credentials command %s returned no apiKey or secretKey", name)
+		}
+		return Credentials{APIKey: output.APIKey, SecretKey: output.SecretKey, Expires: output.Expires}, nil
+	})
+}
+
+// ChainCredentials provides the credentials of the first provider which has some. Providers failing with
+// ErrNoCredentials are skipped, while other errors are returned straight away.
+func ChainCredentials(providers ...CredentialsProvider) CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		var missing []string
+		for _, provider := range providers {
+			credentials, err := provider.Retrieve(ctx)
+			if err == nil {
+				return credentials, nil
+			}
+			if !errors.Is(err, ErrNoCredentials) {
+				return Credentials{}, err
+			}
+			missing = append(missing, err.Error())
+		}
+		return Credentials{}, fmt.Errorf("%w in any provider: %s", ErrNoCredentials, strings.Join(missing, "; "))
+	})
+}
+
+// credentialsCache keeps the credentials of a provider between requests.
+type credentialsCache struct {
+	provider CredentialsProvider
+	now      func() time.Time
+
+	mu      sync.Mutex
+	current *Credentials
+}
+
+func newCredentialsCache(provider CredentialsProvider) *credentialsCache {
+	return &credentialsCache{provider: provider, now: time.Now}
+}
+
+// get returns the cached credentials, retrieving them when there are none yet or they have expired.
+func (c *credentialsCache) get(ctx context.Context) (Credentials, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.current != nil && (c.current.Expires.IsZero() || c.now().Before(c.current.Expires)) {
+		return *c.current, nil
+	}
+	return c.retrieve(ctx)
+}
+
+// refresh retrieves the credentials again after used were refused, unless another request already has, and reports
+// whether they differ from used.
+func (c *credentialsCache) refresh(ctx context.Context, used Credentials) (Credentials, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.current != nil && !sameKeys(*c.current, used) {
+		return *c.current, true, nil
+	}
+	credentials, err := c.retrieve(ctx)
+	if err != nil {
+		return Credentials{}, false, err
+	}
+	return credentials, !sameKeys(credentials, used), nil
+}
+
+func (c *credentialsCache) retrieve(ctx context.Context) (Credentials, error) {
+	credentials, err := c.provider.Retrieve(ctx)
+	if err != nil {
+		c.current = nil
+		return Credentials{}, fmt.Errorf("failed to retrieve credentials: %w", err)
+	}
+	c.current = &credentials
+	return credentials, nil
+}
+
+func sameKeys(a, b Credentials) bool {
+	return a.APIKey == b.APIKey && a.SecretKey == b.SecretKey
+}
@@ -0,0 +1,227 @@
+package rediscloud_api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rotatingServer accepts the keys in accepted, answering 401 Unauthorized to any other, and records the bodies and keys
+// of the requests it receives.
+type rotatingServer struct {
+	accepted atomic.Value
+	keys     []string
+	bodies   []string
+}
+
+func (s *rotatingServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	key := r.Header.Get("X-Api-Key")
+	s.keys = append(s.keys, key)
+	s.bodies = append(s.bodies, string(body))
+	if key+"/"+r.Header.Get("X-Api-Secret-Key") != s.accepted.Load().(string) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error": "unauthorized"}`))
+		return
+	}
+	_, _ = w.Write([]byte(`{"taskId": "task", "status": "processing-completed"}`))
+}
+
+func writeKeys(t *testing.T, dir, apiKey, secretKey string) (string, string) {
+	apiKeyFile, secretKeyFile := filepath.Join(dir, "api-key"), filepath.Join(dir, "secret-key")
+	require.NoError(t, os.WriteFile(apiKeyFile, []byte(apiKey+"\n"), 0o600))
+	require.NoError(t, os.WriteFile(secretKeyFile, []byte(secretKey+"\n"), 0o600))
+	return apiKeyFile, secretKeyFile
+}
+
+func TestCredentials_retriesWithRotatedFileCredentials(t *testing.T) {
+	server := &rotatingServer{}
+	server.accepted.Store("key-1/secret-1")
+	s := httptest.NewServer(server)
+	defer s.Close()
+
+	dir := t.TempDir()
+	apiKeyFile, secretKeyFile := writeKeys(t, dir, "key-1", "secret-1")
+	subject, err := NewClient(BaseURL(s.URL+"/v1"), AuthProvider(FileCredentials(apiKeyFile, secretKeyFile)), Transporter(s.Client().Transport))
+	require.NoError(t, err)
+
+	_, err = subject.Tasks.Get(context.TODO(), "task")
+	require.NoError(t, err)
+
+	// The keys are rotated in place, and the old ones revoked
+	writeKeys(t, dir, "key-2", "secret-2")
+	server.accepted.Store("key-2/secret-2")
+
+	_, err = subject.Tasks.Get(context.TODO(), "task")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key-1", "key-1", "key-2"}, server.keys)
+
+	// The refreshed credentials are kept for later requests
+	_, err = subject.Tasks.Get(context.TODO(), "task")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key-1", "key-1", "key-2", "key-2"}, server.keys)
+}
+
+func TestCredentials_doesNotRetryWithUnchangedCredentials(t *testing.T) {
+	server := &rotatingServer{}
+	server.accepted.Store("other/other")
+	s := httptest.NewServer(server)
+	defer s.Close()
+
+	var retrieved int
+	provider := CredentialsProviderFunc(func(context.Context) (Credentials, error) {
+		retrieved++
+		return Credentials{APIKey: "key", SecretKey: "secret"}, nil
+	})
+	subject, err := NewClient(BaseURL(s.URL+"/v1"), AuthProvider(provider), Transporter(s.Client().Transport))
+	require.NoError(t, err)
+
+	_, err = subject.Tasks.Get(context.TODO(), "task")
+
+	var target *internal.HTTPError
+	require.ErrorAs(t, err, &target)
+	assert.Equal(t, http.StatusUnauthorized, target.StatusCode)
+	assert.Equal(t, []string{"key"}, server.keys)
+	assert.Equal(t, 2, retrieved)
+}
+
+func TestCredentialTripper_replaysBodyOnRetry(t *testing.T) {
+	server := &rotatingServer{}
+	server.accepted.Store("new/new")
+	s := httptest.NewServer(server)
+	defer s.Close()
+
+	keys := []string{"old", "new"}
+	provider := CredentialsProviderFunc(func(context.Context) (Credentials, error) {
+		key := keys[0]
+		keys = keys[1:]
+		return Credentials{APIKey: key, SecretKey: key}, nil
+	})
+	subject := &credentialTripper{
+		credentials: newCredentialsCache(provider),
+		wrapped:     s.Client().Transport,
+		logger:      &defaultLogger{},
+		userAgent:   "test-user-agent",
+	}
+
+	request, err := http.NewRequest(http.MethodPost, s.URL, strings.NewReader(`{"name": "example"}`))
+	require.NoError(t, err)
+	response, err := subject.RoundTrip(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, []string{"old", "new"}, server.keys)
+	assert.Equal(t, []string{`{"name": "example"}`, `{"name": "example"}`}, server.bodies)
+}
+
+func TestCredentialsCache_retrievesExpiredCredentials(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	var retrieved int
+	subject := newCredentialsCache(CredentialsProviderFunc(func(context.Context) (Credentials, error) {
+		retrieved++
+		return Credentials{APIKey: "key", SecretKey: "secret", Expires: now.Add(time.Minute)}, nil
+	}))
+	subject.now = func() time.Time { return now }
+
+	_, err := subject.get(context.TODO())
+	require.NoError(t, err)
+	_, err = subject.get(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, 1, retrieved)
+
+	now = now.Add(2 * time.Minute)
+	_, err = subject.get(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, 2, retrieved)
+}
+
+func TestCredentialsCache_failsWhenProviderFails(t *testing.T) {
+	subject := newCredentialsCache(CredentialsProviderFunc(func(context.Context) (Credentials, error) {
+		return Credentials{}, errors.New("vault is sealed")
+	}))
+
+	_, err := subject.get(context.TODO())
+	assert.EqualError(t, err, "failed to retrieve credentials: vault is sealed")
+}
+
+func TestEnvCredentials(t *testing.T) {
+	t.Setenv(AccessKeyEnvVar, "")
+	t.Setenv(SecretKeyEnvVar, "")
+
+	_, err := EnvCredentials().Retrieve(context.TODO())
+	assert.ErrorIs(t, err, ErrNoCredentials)
+
+	t.Setenv(AccessKeyEnvVar, "key")
+	t.Setenv(SecretKeyEnvVar, "secret")
+
+	actual, err := EnvCredentials().Retrieve(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{APIKey: "key", SecretKey: "secret"}, actual)
+}
+
+func TestFileCredentials(t *testing.T) {
+	dir := t.TempDir()
+	missing := FileCredentials(filepath.Join(dir, "api-key"), filepath.Join(dir, "secret-key"))
+
+	_, err := missing.Retrieve(context.TODO())
+	assert.ErrorIs(t, err, ErrNoCredentials)
+
+	apiKeyFile, secretKeyFile := writeKeys(t, dir, "  key", "secret  ")
+	actual, err := FileCredentials(apiKeyFile, secretKeyFile).Retrieve(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{APIKey: "key", SecretKey: "secret"}, actual)
+}
+
+func TestCommandCredentials(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs a POSIX shell")
+	}
+
+	actual, err := CommandCredentials("sh", "-c", `echo '{"apiKey": "key", "secretKey": "secret", "expires": "2024-01-02T15:04:05Z"}'`).Retrieve(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{APIKey: "key", SecretKey: "secret", Expires: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)}, actual)
+
+	_, err = CommandCredentials("sh", "-c", "echo denied >&2; exit 3").Retrieve(context.TODO())
+	assert.EqualError(t, err, "credentials command sh failed: exit status 3: denied")
+
+	_, err = CommandCredentials("sh", "-c", "echo '{}'").Retrieve(context.TODO())
+	assert.EqualError(t, err, "credentials command sh returned no apiKey or secretKey")
+}
+
+func TestChainCredentials(t *testing.T) {
+	t.Setenv(AccessKeyEnvVar, "")
+	t.Setenv(SecretKeyEnvVar, "")
+	dir := t.TempDir()
+
+	subject := ChainCredentials(
+		EnvCredentials(),
+		FileCredentials(filepath.Join(dir, "api-key"), filepath.Join(dir, "secret-key")),
+		StaticCredentials("key", "secret"),
+	)
+	actual, err := subject.Retrieve(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{APIKey: "key", SecretKey: "secret"}, actual)
+
+	_, err = ChainCredentials(EnvCredentials()).Retrieve(context.TODO())
+	assert.ErrorIs(t, err, ErrNoCredentials)
+
+	failing := CredentialsProviderFunc(func(context.Context) (Credentials, error) {
+		return Credentials{}, errors.New("permission denied")
+	})
+	_, err = ChainCredentials(failing, StaticCredentials("key", "secret")).Retrieve(context.TODO())
+	assert.EqualError(t, err, "permission denied")
+}
@@ -97,8 +97,8 @@ func TestDatabase_Create(t *testing.T) {
 		SupportOSSClusterAPI:                redis.Bool(false),
 		RespVersion:                         redis.String("resp3"),
 		UseExternalEndpointForOSSClusterAPI: redis.Bool(false),
-		DataPersistence:                     redis.String("none"),
-		DataEvictionPolicy:                  redis.String("allkeys-lru"),
+		DataPersistence:                     redis.Ptr(databases.DataPersistenceNone),
+		DataEvictionPolicy:                  redis.Ptr(databases.EvictionPolicyAllKeysLRU),
 		QueryPerformanceFactor:              redis.String("6x"),
 		RedisVersion:                        redis.String("6.0.5"),
 		Replication:                         redis.Bool(true),
@@ -286,19 +286,19 @@ func TestDatabase_Get(t *testing.T) {
 		Protocol:             redis.String("redis"),
 		Provider:             redis.String("AWS"),
 		Region:               redis.String("eu-west-1"),
-		Status:               redis.String("active"),
+		Status:               redis.Ptr(databases.StatusActive),
 		MemoryLimitInGB:      redis.Float64(7),
 		DatasetSizeInGB:      redis.Float64(7),
 		RamPercentage:        redis.Int(20),
 		MemoryUsedInMB:       redis.Float64(5),
 		SupportOSSClusterAPI: redis.Bool(true),
 		RespVersion:          redis.String("resp2"),
-		DataPersistence:      redis.String("none"),
+		DataPersistence:      redis.Ptr(databases.DataPersistenceNone),
 		Replication:          redis.Bool(false),
 		ReplicaOf: &databases.ReplicaOf{
 			Endpoints: []*string{redis.String("another")},
 		},
-		DataEvictionPolicy:     redis.String("volatile-random"),
+		DataEvictionPolicy:     redis.Ptr(databases.EvictionPolicyVolatileRandom),
 		ActivatedOn:            redis.Time(time.Date(2020, 11, 3, 9, 3, 30, 0, time.UTC)),
 		LastModified:           redis.Time(time.Date(2020, 11, 3, 9, 3, 30, 0, time.UTC)),
 		MemoryStorage:          redis.String("ram"),
@@ -409,27 +409,27 @@ func TestDatabase_Update(t *testing.T) {
 		SupportOSSClusterAPI:                redis.Bool(false),
 		RespVersion:                         redis.String("resp3"),
 		UseExternalEndpointForOSSClusterAPI: redis.Bool(false),
-		DataPersistence:                     redis.String("none"),
-		DataEvictionPolicy:                  redis.String("allkeys-lru"),
+		DataPersistence:                     redis.Ptr(databases.DataPersistenceNone),
+		DataEvictionPolicy:                  redis.Ptr(databases.EvictionPolicyAllKeysLRU),
 		Replication:                         redis.Bool(true),
 		ThroughputMeasurement: &databases.UpdateThroughputMeasurement{
 			By:    redis.String("operations-per-second"),
 			Value: redis.Int(1000),
 		},
 		RegexRules:            redis.StringSlice(".*"),
-		ReplicaOf:             redis.StringSlice("another"),
+		ReplicaOf:             redis.Set(redis.StringSlice("another")),
 		PeriodicBackupPath:    redis.String("s3://bucket-name"),
 		SourceIP:              redis.StringSlice("10.0.0.1"),
 		ClientSSLCertificate:  redis.String("something"),
-		ClientTLSCertificates: &[]*string{redis.String("something"), redis.String("new")},
+		ClientTLSCertificates: redis.Set([]*string{redis.String("something"), redis.String("new")}),
 		EnableTls:             redis.Bool(false),
 		Password:              redis.String("fooBar"),
-		Alerts: &[]*databases.Alert{
+		Alerts: redis.Set([]*databases.Alert{
 			{
 				Name:  redis.String("dataset-size"),
 				Value: redis.Int(80),
 			},
-		},
+		}),
 		EnableDefaultUser:       redis.Bool(false),
 		QueryPerformanceFactor:  redis.String("2x"),
 		AutoMinorVersionUpgrade: redis.Bool(true),
@@ -545,3 +545,207 @@ func TestDatabase_UpgradeRedisVersion(t *testing.T) {
 	)
 	require.NoError(t, err)
 }
+
+func TestDatabase_Clone(t *testing.T) {
+	flow := cloneFlow(t)
+	flow = append(flow, taskFlow(t, http.MethodPost, "/subscriptions/43/databases/99/import", `{
+  "sourceType": "aws-s3",
+  "importFromUri": ["s3://backups/production/backup.rdb"]
+}`, "import-task", "databaseImportRequest")...)
+
+	s := httptest.NewServer(testServer("key", "secret", flow...))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Database.Clone(context.TODO(), 42, 18, 43, cloneOptions())
+	require.NoError(t, err)
+	assert.Equal(t, 99, actual)
+}
+
+func TestDatabase_Clone_waitsForEveryStepWithNoWait(t *testing.T) {
+	flow := cloneFlow(t)
+	flow = append(flow, taskFlow(t, http.MethodPost, "/subscriptions/43/databases/99/import", `{
+  "sourceType": "aws-s3",
+  "importFromUri": ["s3://backups/production/backup.rdb"]
+}`, "import-task", "databaseImportRequest")...)
+
+	s := httptest.NewServer(testServer("key", "secret", flow...))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	ctx := WithCallOptions(context.TODO(), CallNoWait(nil))
+	actual, err := subject.Database.Clone(ctx, 42, 18, 43, cloneOptions())
+	require.NoError(t, err)
+	assert.Equal(t, 99, actual)
+}
+
+func TestDatabase_Clone_deletesCloneWhenImportFails(t *testing.T) {
+	flow := cloneFlow(t)
+	flow = append(flow, postRequest(t, "/subscriptions/43/databases/99/import", `{
+  "sourceType": "aws-s3",
+  "importFromUri": ["s3://backups/production/backup.rdb"]
+}`, `{
+  "taskId": "import-task",
+  "commandType": "databaseImportRequest",
+  "status": "received"
+}`), getRequest(t, "/tasks/import-task", `{
+  "taskId": "import-task",
+  "commandType": "databaseImportRequest",
+  "status": "processing-error",
+  "response": {
+    "error": {
+      "type": "DATABASE_IMPORT_FAILED",
+      "status": "400 BAD_REQUEST",
+      "description": "The RDB file could not be read."
+    }
+  }
+}`))
+	flow = append(flow, taskFlow(t, http.MethodDelete, "/subscriptions/43/databases/99", "", "delete-task", "databaseDeleteRequest")...)
+
+	s := httptest.NewServer(testServer("key", "secret", flow...))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Database.Clone(context.TODO(), 42, 18, 43, cloneOptions())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "The RDB file could not be read.")
+	assert.Equal(t, 0, actual)
+}
+
+func TestDatabase_Clone_requiresImportFromToCopyData(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret", getRequest(t, "/subscriptions/42/databases/18", cloneSource)))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	options := cloneOptions()
+	options.ImportFrom = nil
+	_, err = subject.Database.Clone(context.TODO(), 42, 18, 43, options)
+	assert.EqualError(t, err, "ImportFrom must name the RDB file(s) to import from the backup destination s3://backups/production")
+}
+
+func cloneOptions() databases.CloneOptions {
+	return databases.CloneOptions{
+		Overrides: &databases.CreateDatabase{
+			Name:     redis.String("staging"),
+			Password: redis.String("staging-password"),
+		},
+		CopyData: true,
+		ImportFrom: &databases.Import{
+			ImportFromURI: redis.StringSlice("s3://backups/production/backup.rdb"),
+		},
+	}
+}
+
+// cloneFlow returns the requests cloning cloneSource into database 99 of subscription 43 make, up to the import.
+func cloneFlow(t *testing.T) []endpointRequest {
+	flow := []endpointRequest{
+		getRequest(t, "/subscriptions/42/databases/18", cloneSource),
+	}
+	flow = append(flow, taskFlow(t, http.MethodPost, "/subscriptions/42/databases/18/backup", "", "backup-task", "databaseBackupRequest")...)
+	return append(flow, postRequest(t, "/subscriptions/43/databases", `{
+  "name": "staging",
+  "protocol": "redis",
+  "datasetSizeInGb": 1,
+  "dataPersistence": "none",
+  "dataEvictionPolicy": "allkeys-lru",
+  "replication": true,
+  "throughputMeasurement": {
+    "by": "operations-per-second",
+    "value": 1000
+  },
+  "sourceIp": ["0.0.0.0/0"],
+  "password": "staging-password",
+  "remoteBackup": {
+    "active": true,
+    "interval": "every-24-hours",
+    "storageType": "aws-s3",
+    "storagePath": "s3://backups/production"
+  }
+}`, `{
+  "taskId": "create-task",
+  "commandType": "databaseCreateRequest",
+  "status": "received"
+}`), getRequest(t, "/tasks/create-task", `{
+  "taskId": "create-task",
+  "commandType": "databaseCreateRequest",
+  "status": "processing-completed",
+  "response": {
+    "resourceId": 99
+  }
+}`))
+}
+
+const cloneSource = `{
+  "databaseId": 18,
+  "name": "production",
+  "protocol": "redis",
+  "status": "active",
+  "datasetSizeInGb": 1,
+  "dataPersistence": "none",
+  "dataEvictionPolicy": "allkeys-lru",
+  "replication": true,
+  "throughputMeasurement": {
+    "by": "operations-per-second",
+    "value": 1000
+  },
+  "security": {
+    "sourceIps": ["0.0.0.0/0"]
+  },
+  "backup": {
+    "enableRemoteBackup": true,
+    "interval": "every-24-hours",
+    "destination": "s3://backups/production"
+  }
+}`
+
+func TestDatabase_Clone_requiresMissingFields(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret", getRequest(t, "/subscriptions/42/databases/18", `{
+  "databaseId": 18,
+  "name": "production",
+  "protocol": "redis",
+  "security": {
+    "tlsClientAuthentication": true
+  }
+}`)))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	_, err = subject.Database.Clone(context.TODO(), 42, 18, 43, databases.CloneOptions{})
+	assert.EqualError(t, err, "the following fields must be supplied: password, clientTlsCertificates")
+}
+
+func TestDatabase_Create_validatesRequest(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+
+	subject, err := NewClient(ValidateRequests(true), BaseURL(s.URL+"/v1"), Auth("key", "secret"), Transporter(s.Client().Transport))
+	require.NoError(t, err)
+
+	_, err = subject.Database.Create(context.TODO(), 42, databases.CreateDatabase{
+		Protocol:           redis.String("redis"),
+		MemoryLimitInGB:    redis.Float64(1),
+		DatasetSizeInGB:    redis.Float64(1),
+		DataEvictionPolicy: redis.Ptr(databases.EvictionPolicy("lru")),
+		SourceIP:           redis.StringSlice("10.0.0.0/33"),
+	})
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []string{
+		"name is required",
+		`dataEvictionPolicy must be one of allkeys-lru, allkeys-lfu, allkeys-random, volatile-lru, volatile-lfu, volatile-random, volatile-ttl, noeviction, got "lru"`,
+		"only one of datasetSizeInGb, memoryLimitInGb may be set",
+		`sourceIp[0] must be an IP address or CIDR block, got "10.0.0.0/33"`,
+	}, validationErr.Problems)
+}
@@ -0,0 +1,149 @@
+package rediscloud_api
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+	"github.com/RedisLabs/rediscloud-go-api/service/regions"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dryRunPricing = `{
+  "taskId": "task",
+  "commandType": "databaseCreateRequest",
+  "status": "processing-completed",
+  "response": {
+    "resource": {
+      "pricing": [
+        {
+          "databaseName": "example",
+          "type": "Shards",
+          "quantity": 2,
+          "quantityMeasurement": "shards",
+          "pricePerUnit": 0.124,
+          "priceCurrency": "USD",
+          "pricePeriod": "hour",
+          "region": "eu-west-1"
+        }
+      ]
+    }
+  }
+}`
+
+var expectedDryRunPricing = []*pricing.Pricing{
+	{
+		DatabaseName:        redis.String("example"),
+		Type:                redis.String("Shards"),
+		Quantity:            redis.Int(2),
+		QuantityMeasurement: redis.String("shards"),
+		PricePerUnit:        redis.Float64(0.124),
+		PriceCurrency:       redis.String("USD"),
+		PricePeriod:         redis.String("hour"),
+		Region:              redis.String("eu-west-1"),
+	},
+}
+
+func TestDatabase_DryRunCreate(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		postRequest(t, "/subscriptions/42/databases", `{"dryRun": true, "name": "example"}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", dryRunPricing),
+	))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Database.DryRunCreate(context.TODO(), 42, databases.CreateDatabase{Name: redis.String("example")})
+	require.NoError(t, err)
+	assert.Equal(t, &pricing.DryRunResult{Valid: true, Pricing: expectedDryRunPricing}, actual)
+}
+
+func TestDatabase_DryRunCreate_refused(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		postRequest(t, "/subscriptions/42/databases", `{"dryRun": true, "name": "example", "memoryLimitInGb": 5000}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", `{
+		  "taskId": "task",
+		  "status": "processing-error",
+		  "response": {"error": {"type": "DATABASE_MEMORY_LIMIT_EXCEEDED", "status": "400 BAD_REQUEST", "description": "Memory limit is too high."}}
+		}`),
+	))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Database.DryRunCreate(context.TODO(), 42, databases.CreateDatabase{Name: redis.String("example"), MemoryLimitInGB: redis.Float64(5000)})
+	require.NoError(t, err)
+	assert.False(t, actual.Valid)
+	assert.ErrorContains(t, actual.Refusal, "Memory limit is too high.")
+	assert.Empty(t, actual.Pricing)
+	assert.Contains(t, actual.String(), "Memory limit is too high.")
+}
+
+func TestDatabase_DryRunUpdate(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		putRequest(t, "/subscriptions/42/databases/18", `{"dryRun": true, "replication": true}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", dryRunPricing),
+	))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Database.DryRunUpdate(context.TODO(), 42, 18, databases.UpdateDatabase{Replication: redis.Bool(true)})
+	require.NoError(t, err)
+	assert.Equal(t, &pricing.DryRunResult{Valid: true, Pricing: expectedDryRunPricing}, actual)
+}
+
+func TestDatabase_ActiveActiveDryRunCreate(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		postRequest(t, "/subscriptions/42/databases", `{"dryRun": true, "name": "example"}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", dryRunPricing),
+	))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Database.ActiveActiveDryRunCreate(context.TODO(), 42, databases.CreateActiveActiveDatabase{Name: redis.String("example")})
+	require.NoError(t, err)
+	assert.Equal(t, &pricing.DryRunResult{Valid: true, Pricing: expectedDryRunPricing}, actual)
+}
+
+func TestSubscription_DryRunCreate(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		postRequest(t, "/subscriptions", `{"dryRun": true, "name": "example"}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", dryRunPricing),
+	))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	// The task holds the outcome, so it's waited for whatever the call options
+	ctx := WithCallOptions(context.TODO(), CallNoWait(nil))
+	actual, err := subject.Subscription.DryRunCreate(ctx, subscriptions.CreateSubscription{Name: redis.String("example")})
+	require.NoError(t, err)
+	assert.Equal(t, &pricing.DryRunResult{Valid: true, Pricing: expectedDryRunPricing}, actual)
+}
+
+func TestRegions_DryRunCreate(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		postRequest(t, "/subscriptions/42/regions", `{"region": "us-east-1", "dryRun": true}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", dryRunPricing),
+	))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Regions.DryRunCreate(context.TODO(), 42, regions.CreateRegion{Region: redis.String("us-east-1")})
+	require.NoError(t, err)
+	assert.Equal(t, &pricing.DryRunResult{Valid: true, Pricing: expectedDryRunPricing}, actual)
+}
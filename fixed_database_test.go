@@ -87,8 +87,8 @@ func TestFixedDatabase_Create(t *testing.T) {
 			Protocol:           redis.String("memcached"),
 			RedisVersion:       redis.String("7.4"),
 			RespVersion:        redis.String("resp2"),
-			DataPersistence:    redis.String("none"),
-			DataEvictionPolicy: redis.String("noeviction"),
+			DataPersistence:    redis.Ptr(databases.DataPersistenceNone),
+			DataEvictionPolicy: redis.Ptr(databases.EvictionPolicyNoEviction),
 			Replication:        redis.Bool(false),
 			Alerts:             &[]*databases.Alert{},
 		},
@@ -233,16 +233,16 @@ func TestFixedDatabase_List(t *testing.T) {
 			Region:                              redis.String("us-west-1"),
 			RedisVersion:                        redis.String("7.4"),
 			RespVersion:                         redis.String("resp2"),
-			Status:                              redis.String("draft"),
+			Status:                              redis.Ptr(databases.StatusDraft),
 			PlanMemoryLimit:                     redis.Float64(1),
 			MemoryLimitMeasurementUnit:          redis.String("GB"),
 			MemoryUsedInMb:                      redis.Float64(7),
 			MemoryStorage:                       redis.String("ram"),
 			SupportOSSClusterAPI:                redis.Bool(false),
 			UseExternalEndpointForOSSClusterAPI: redis.Bool(false),
-			DataPersistence:                     redis.String("none"),
+			DataPersistence:                     redis.Ptr(databases.DataPersistenceNone),
 			Replication:                         redis.Bool(false),
-			DataEvictionPolicy:                  redis.String("noeviction"),
+			DataEvictionPolicy:                  redis.Ptr(databases.EvictionPolicyNoEviction),
 			ActivatedOn:                         redis.Time(time.Date(2024, 5, 14, 9, 27, 48, 0, time.UTC)),
 			Clustering: &fixedDatabases.Clustering{
 				Enabled: redis.Bool(true),
@@ -361,16 +361,16 @@ func TestFixedDatabase_Get(t *testing.T) {
 		Region:                              redis.String("us-west-1"),
 		RedisVersion:                        redis.String("7.4"),
 		RespVersion:                         redis.String("resp2"),
-		Status:                              redis.String("draft"),
+		Status:                              redis.Ptr(databases.StatusDraft),
 		PlanMemoryLimit:                     redis.Float64(1),
 		MemoryLimitMeasurementUnit:          redis.String("GB"),
 		MemoryUsedInMb:                      redis.Float64(7),
 		MemoryStorage:                       redis.String("ram"),
 		SupportOSSClusterAPI:                redis.Bool(false),
 		UseExternalEndpointForOSSClusterAPI: redis.Bool(false),
-		DataPersistence:                     redis.String("none"),
+		DataPersistence:                     redis.Ptr(databases.DataPersistenceNone),
 		Replication:                         redis.Bool(false),
-		DataEvictionPolicy:                  redis.String("noeviction"),
+		DataEvictionPolicy:                  redis.Ptr(databases.EvictionPolicyNoEviction),
 		ActivatedOn:                         redis.Time(time.Date(2024, 5, 10, 14, 14, 33, 0, time.UTC)),
 		Clustering: &fixedDatabases.Clustering{
 			Enabled: redis.Bool(true),
@@ -481,16 +481,16 @@ func TestFixedDatabase_Update(t *testing.T) {
 		fixedDatabases.UpdateFixedDatabase{
 			Name:               redis.String("my-test-fixed-database"),
 			RespVersion:        redis.String("resp2"),
-			DataPersistence:    redis.String("none"),
-			DataEvictionPolicy: redis.String("volatile-lru"),
+			DataPersistence:    redis.Ptr(databases.DataPersistenceNone),
+			DataEvictionPolicy: redis.Ptr(databases.EvictionPolicyVolatileLRU),
 			Replication:        redis.Bool(false),
 			EnableDefaultUser:  redis.Bool(true),
-			Alerts: &[]*databases.Alert{
+			Alerts: redis.Set([]*databases.Alert{
 				{
 					Name:  redis.String("datasets-size"),
 					Value: redis.Int(80),
 				},
-			},
+			}),
 		},
 	)
 
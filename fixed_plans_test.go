@@ -225,3 +225,107 @@ func Test_ListWithProvider(t *testing.T) {
 		},
 	}, list)
 }
+
+func Test_Select(t *testing.T) {
+	s := httptest.NewServer(
+		testServer("apiKey", "secret",
+			getRequestWithQuery(t, "/fixed/plans", map[string][]string{"provider": {"AWS"}}, `{
+  "plans": [
+    {"id": 1, "name": "Small", "size": 250, "sizeMeasurementUnit": "MB", "provider": "AWS", "region": "us-east-1",
+     "price": 5, "priceCurrency": "USD", "pricePeriod": "Month", "maximumThroughput": 1000,
+     "supportReplication": true, "supportDataPersistence": true},
+    {"id": 2, "name": "Medium", "size": 1, "sizeMeasurementUnit": "GB", "provider": "AWS", "region": "us-east-1",
+     "price": 22, "priceCurrency": "USD", "pricePeriod": "Month", "maximumThroughput": 2000,
+     "supportReplication": true, "supportDataPersistence": true},
+    {"id": 3, "name": "Large", "size": 2.5, "sizeMeasurementUnit": "GB", "provider": "AWS", "region": "us-east-1",
+     "price": 22, "priceCurrency": "USD", "pricePeriod": "Month", "maximumThroughput": 2500,
+     "supportReplication": true, "supportDataPersistence": true},
+    {"id": 4, "name": "Elsewhere", "size": 1, "sizeMeasurementUnit": "GB", "provider": "AWS", "region": "eu-west-1",
+     "price": 10, "priceCurrency": "USD", "pricePeriod": "Month", "maximumThroughput": 2000,
+     "supportReplication": true, "supportDataPersistence": false},
+    {"id": 5, "name": "Expensive", "size": 5, "sizeMeasurementUnit": "GB", "provider": "AWS", "region": "us-east-1",
+     "price": 60, "priceCurrency": "USD", "pricePeriod": "Month", "maximumThroughput": 5000,
+     "supportReplication": true, "supportDataPersistence": true}
+  ]
+}`),
+		),
+	)
+
+	subject, err := clientFromTestServer(s, "apiKey", "secret")
+	require.NoError(t, err)
+
+	selection, err := subject.FixedPlans.Select(context.TODO(), plans.Requirements{
+		Provider:        "AWS",
+		Region:          "US-EAST-1",
+		MinSizeGB:       1,
+		Replication:     true,
+		Persistence:     true,
+		MinThroughput:   1500,
+		Currency:        "USD",
+		MaxMonthlyPrice: 50,
+	})
+	require.NoError(t, err)
+
+	var matches []int
+	for _, plan := range selection.Matches {
+		matches = append(matches, redis.IntValue(plan.ID))
+	}
+	// At the same price, the larger plan comes first
+	assert.Equal(t, []int{3, 2}, matches)
+
+	excluded := map[int][]string{}
+	for _, exclusion := range selection.Excluded {
+		excluded[redis.IntValue(exclusion.Plan.ID)] = exclusion.Reasons
+	}
+	assert.Equal(t, map[int][]string{
+		1: {"size is 0.244140625 GB, less than 1 GB", "throughput is at most 1000 ops/sec, less than 1500"},
+		4: {"region is eu-west-1, not US-EAST-1", "data persistence isn't supported"},
+		5: {"price is 60 USD per month, more than 50"},
+	}, excluded)
+}
+
+func Test_Select_mixedCurrenciesAndPeriods(t *testing.T) {
+	list := `{
+  "plans": [
+    {"id": 1, "name": "Monthly", "price": 60, "priceCurrency": "USD", "pricePeriod": "Month"},
+    {"id": 2, "name": "Hourly", "price": 1, "priceCurrency": "USD", "pricePeriod": "Hour"},
+    {"id": 3, "name": "Euros", "price": 5, "priceCurrency": "EUR", "pricePeriod": "Month"},
+    {"id": 4, "name": "Yearly", "price": 10, "priceCurrency": "USD", "pricePeriod": "Year"}
+  ]
+}`
+	s := httptest.NewServer(testServer("apiKey", "secret", getRequest(t, "/fixed/plans", list), getRequest(t, "/fixed/plans", list)))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "apiKey", "secret")
+	require.NoError(t, err)
+
+	ids := func(list []*plans.GetPlanResponse) []int {
+		var ids []int
+		for _, plan := range list {
+			ids = append(ids, redis.IntValue(plan.ID))
+		}
+		return ids
+	}
+
+	// Ranked by currency, then by the monthly price, with the hourly plan costing 730 per month
+	selection, err := subject.FixedPlans.Select(context.TODO(), plans.Requirements{})
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 1, 2, 4}, ids(selection.Matches))
+
+	selection, err = subject.FixedPlans.Select(context.TODO(), plans.Requirements{Currency: "usd", MaxMonthlyPrice: 100})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, ids(selection.Matches))
+
+	excluded := map[int][]string{}
+	for _, exclusion := range selection.Excluded {
+		excluded[redis.IntValue(exclusion.Plan.ID)] = exclusion.Reasons
+	}
+	assert.Equal(t, map[int][]string{
+		2: {"price is 730 USD per month, more than 100"},
+		3: {"currency is EUR, not usd"},
+		4: {"price is per Year, which can't be compared per month"},
+	}, excluded)
+
+	_, err = subject.FixedPlans.Select(context.TODO(), plans.Requirements{MaxMonthlyPrice: 100})
+	assert.EqualError(t, err, "a currency is needed to compare plans with the maximum monthly price")
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/RedisLabs/rediscloud-go-api/redis"
 	fixedSubscriptions "github.com/RedisLabs/rediscloud-go-api/service/fixed/subscriptions"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -278,7 +279,7 @@ func TestFixedSubscription_List(t *testing.T) {
 		{
 			ID:              redis.Int(111614),
 			Name:            redis.String("My test fixed subscription"),
-			Status:          redis.String("active"),
+			Status:          redis.Ptr(fixedSubscriptions.FixedSubscriptionStatusActive),
 			PlanId:          redis.Int(34858),
 			PaymentMethodID: redis.Int(30949),
 			PaymentMethod:   redis.String("credit-card"),
@@ -287,7 +288,7 @@ func TestFixedSubscription_List(t *testing.T) {
 		{
 			ID:              redis.Int(111615),
 			Name:            redis.String("Another test fixed subscription"),
-			Status:          redis.String("active"),
+			Status:          redis.Ptr(fixedSubscriptions.FixedSubscriptionStatusActive),
 			PlanId:          redis.Int(34858),
 			PaymentMethodID: redis.Int(30949),
 			PaymentMethod:   redis.String("credit-card"),
@@ -350,7 +351,7 @@ func TestFixedSubscription_Get(t *testing.T) {
 	assert.Equal(t, &fixedSubscriptions.FixedSubscriptionResponse{
 		ID:              redis.Int(111614),
 		Name:            redis.String("My test fixed subscription"),
-		Status:          redis.String("active"),
+		Status:          redis.Ptr(fixedSubscriptions.FixedSubscriptionStatusActive),
 		PlanId:          redis.Int(34858),
 		PaymentMethod:   redis.String("credit-card"),
 		PaymentMethodID: redis.Int(30949),
@@ -503,3 +504,71 @@ func TestFixedSubscription_Delete(t *testing.T) {
 	err = subject.FixedSubscriptions.Delete(context.TODO(), 111614)
 	require.NoError(t, err)
 }
+
+func TestFixedSubscription_DeleteCascade(t *testing.T) {
+	requests := []endpointRequest{
+		getRequest(t, "/fixed/subscriptions/111614", `{
+  "id": 111614,
+  "name": "My test fixed subscription",
+  "status": "active",
+  "planId": 34858
+}`),
+		getRequestWithQuery(t, "/fixed/subscriptions/111614/databases", map[string][]string{"limit": {"100"}, "offset": {"0"}}, `{
+  "accountId": 69369,
+  "subscription": {
+    "subscriptionId": 111614,
+    "numberOfDatabases": 1,
+    "databases": [
+      {"databaseId": 51055698, "name": "my-fixed-database"}
+    ]
+  }
+}`),
+		getRequestWithQuery(t, "/fixed/subscriptions/111614/databases", map[string][]string{"limit": {"100"}, "offset": {"100"}}, `{
+  "accountId": 69369,
+  "subscription": {
+    "subscriptionId": 111614,
+    "numberOfDatabases": 0,
+    "databases": []
+  }
+}`),
+	}
+	requests = append(requests, taskFlow(t, "DELETE", "/fixed/subscriptions/111614/databases/51055698", "", "delete-db", "fixedDatabaseDeleteRequest")...)
+	requests = append(requests, taskFlow(t, "DELETE", "/fixed/subscriptions/111614", "", "delete-sub", "fixedSubscriptionDeleteRequest")...)
+
+	server := httptest.NewServer(testServer("apiKey", "secret", requests...))
+
+	subject, err := clientFromTestServer(server, "apiKey", "secret")
+	require.NoError(t, err)
+
+	plan, err := subject.FixedSubscriptions.DeleteCascade(context.TODO(), 111614, subscriptions.DeleteCascadeOptions{Confirm: "My test fixed subscription"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"delete database 51055698 (my-fixed-database)",
+		"delete subscription 111614",
+	}, plan.Steps)
+}
+
+func TestFixedSubscription_DeleteCascade_rejectsEmptyConfirmation(t *testing.T) {
+	server := httptest.NewServer(testServer("apiKey", "secret",
+		getRequest(t, "/fixed/subscriptions/111614", `{
+  "id": 111614,
+  "status": "active",
+  "planId": 34858
+}`),
+		getRequestWithQuery(t, "/fixed/subscriptions/111614/databases", map[string][]string{"limit": {"100"}, "offset": {"0"}}, `{
+  "accountId": 69369,
+  "subscription": {
+    "subscriptionId": 111614,
+    "numberOfDatabases": 0,
+    "databases": []
+  }
+}`),
+	))
+
+	subject, err := clientFromTestServer(server, "apiKey", "secret")
+	require.NoError(t, err)
+
+	_, err = subject.FixedSubscriptions.DeleteCascade(context.TODO(), 111614, subscriptions.DeleteCascadeOptions{})
+	assert.ErrorIs(t, err, subscriptions.ErrNotConfirmed)
+}
@@ -15,36 +15,36 @@ import (
 )
 
 func TestSubcriptionFixtures(t *testing.T) {
-	assert.Equal(t, "active", subscriptions.SubscriptionStatusActive)
-	assert.Equal(t, "pending", subscriptions.SubscriptionStatusPending)
-	assert.Equal(t, "error", subscriptions.SubscriptionStatusError)
-	assert.Equal(t, "deleting", subscriptions.SubscriptionStatusDeleting)
+	assert.Equal(t, "active", string(subscriptions.SubscriptionStatusActive))
+	assert.Equal(t, "pending", string(subscriptions.SubscriptionStatusPending))
+	assert.Equal(t, "error", string(subscriptions.SubscriptionStatusError))
+	assert.Equal(t, "deleting", string(subscriptions.SubscriptionStatusDeleting))
 
-	assert.Equal(t, "initiating-request", subscriptions.VPCPeeringStatusInitiatingRequest)
-	assert.Equal(t, "active", subscriptions.VPCPeeringStatusActive)
-	assert.Equal(t, "inactive", subscriptions.VPCPeeringStatusInactive)
-	assert.Equal(t, "pending-acceptance", subscriptions.VPCPeeringStatusPendingAcceptance)
-	assert.Equal(t, "failed", subscriptions.VPCPeeringStatusFailed)
+	assert.Equal(t, "initiating-request", string(subscriptions.VPCPeeringStatusInitiatingRequest))
+	assert.Equal(t, "active", string(subscriptions.VPCPeeringStatusActive))
+	assert.Equal(t, "inactive", string(subscriptions.VPCPeeringStatusInactive))
+	assert.Equal(t, "pending-acceptance", string(subscriptions.VPCPeeringStatusPendingAcceptance))
+	assert.Equal(t, "failed", string(subscriptions.VPCPeeringStatusFailed))
 
-	assert.Equal(t, "single-region", subscriptions.SubscriptionDeploymentTypeSingleRegion)
-	assert.Equal(t, "active-active", subscriptions.SubscriptionDeploymentTypeActiveActive)
+	assert.Equal(t, "single-region", string(subscriptions.SubscriptionDeploymentTypeSingleRegion))
+	assert.Equal(t, "active-active", string(subscriptions.SubscriptionDeploymentTypeActiveActive))
 }
 
 func TestDatabaseFixtures(t *testing.T) {
-	assert.Equal(t, "active", databases.StatusActive)
-	assert.Equal(t, "draft", databases.StatusDraft)
-	assert.Equal(t, "pending", databases.StatusPending)
-	assert.Equal(t, "rcp-change-pending", databases.StatusRCPChangePending)
-	assert.Equal(t, "rcp-draft", databases.StatusRCPDraft)
-	assert.Equal(t, "rcp-active-change-draft", databases.StatusRCPActiveChangeDraft)
-	assert.Equal(t, "active-change-draft", databases.StatusActiveChangeDraft)
-	assert.Equal(t, "active-change-pending", databases.StatusActiveChangePending)
-	assert.Equal(t, "dynamic-endpoints-creation-pending", databases.StatusDynamicEndpointsCreationPending)
-	assert.Equal(t, "active-upgrade-pending", databases.StatusActiveUpgradePending)
+	assert.Equal(t, "active", string(databases.StatusActive))
+	assert.Equal(t, "draft", string(databases.StatusDraft))
+	assert.Equal(t, "pending", string(databases.StatusPending))
+	assert.Equal(t, "rcp-change-pending", string(databases.StatusRCPChangePending))
+	assert.Equal(t, "rcp-draft", string(databases.StatusRCPDraft))
+	assert.Equal(t, "rcp-active-change-draft", string(databases.StatusRCPActiveChangeDraft))
+	assert.Equal(t, "active-change-draft", string(databases.StatusActiveChangeDraft))
+	assert.Equal(t, "active-change-pending", string(databases.StatusActiveChangePending))
+	assert.Equal(t, "dynamic-endpoints-creation-pending", string(databases.StatusDynamicEndpointsCreationPending))
+	assert.Equal(t, "active-upgrade-pending", string(databases.StatusActiveUpgradePending))
 
-	assert.Equal(t, "proxy-policy-change-pending", databases.StatusProxyPolicyChangePending)
-	assert.Equal(t, "proxy-policy-change-draft", databases.StatusProxyPolicyChangeDraft)
-	assert.Equal(t, "error", databases.StatusError)
+	assert.Equal(t, "proxy-policy-change-pending", string(databases.StatusProxyPolicyChangePending))
+	assert.Equal(t, "proxy-policy-change-draft", string(databases.StatusProxyPolicyChangeDraft))
+	assert.Equal(t, "error", string(databases.StatusError))
 
 	assert.Equal(t, []string{databases.MemoryStorageRam, databases.MemoryStorageRamAndFlash}, databases.MemoryStorageValues())
 	assert.Equal(t, []string{"redis", "memcached"}, databases.ProtocolValues())
@@ -99,36 +99,36 @@ func TestDatabaseFixtures(t *testing.T) {
 }
 
 func TestCloudAccountFixtures(t *testing.T) {
-	assert.Equal(t, "draft", cloud_accounts.StatusDraft)
-	assert.Equal(t, "pending", cloud_accounts.StatusPending)
-	assert.Equal(t, "active", cloud_accounts.StatusActive)
-	assert.Equal(t, "change-draft", cloud_accounts.StatusChangeDraft)
-	assert.Equal(t, "change-pending", cloud_accounts.StatusChangePending)
-	assert.Equal(t, "delete-draft", cloud_accounts.StatusDeleteDraft)
-	assert.Equal(t, "deleted", cloud_accounts.StatusDeleted)
-	assert.Equal(t, "active-error", cloud_accounts.StatusActiveError)
+	assert.Equal(t, "draft", string(cloud_accounts.StatusDraft))
+	assert.Equal(t, "pending", string(cloud_accounts.StatusPending))
+	assert.Equal(t, "active", string(cloud_accounts.StatusActive))
+	assert.Equal(t, "change-draft", string(cloud_accounts.StatusChangeDraft))
+	assert.Equal(t, "change-pending", string(cloud_accounts.StatusChangePending))
+	assert.Equal(t, "delete-draft", string(cloud_accounts.StatusDeleteDraft))
+	assert.Equal(t, "deleted", string(cloud_accounts.StatusDeleted))
+	assert.Equal(t, "active-error", string(cloud_accounts.StatusActiveError))
 	assert.Equal(t, []string{"AWS", "GCP"}, cloud_accounts.ProviderValues())
 }
 
 func TestRedisRuleFixtures(t *testing.T) {
-	assert.Equal(t, "active", redis_rules.StatusActive)
-	assert.Equal(t, "pending", redis_rules.StatusPending)
-	assert.Equal(t, "error", redis_rules.StatusError)
-	assert.Equal(t, "deleting", redis_rules.StatusDeleting)
+	assert.Equal(t, "active", string(redis_rules.StatusActive))
+	assert.Equal(t, "pending", string(redis_rules.StatusPending))
+	assert.Equal(t, "error", string(redis_rules.StatusError))
+	assert.Equal(t, "deleting", string(redis_rules.StatusDeleting))
 }
 
 func TestRoleFixtures(t *testing.T) {
-	assert.Equal(t, "active", roles.StatusActive)
-	assert.Equal(t, "pending", roles.StatusPending)
-	assert.Equal(t, "error", roles.StatusError)
-	assert.Equal(t, "deleting", roles.StatusDeleting)
+	assert.Equal(t, "active", string(roles.StatusActive))
+	assert.Equal(t, "pending", string(roles.StatusPending))
+	assert.Equal(t, "error", string(roles.StatusError))
+	assert.Equal(t, "deleting", string(roles.StatusDeleting))
 }
 
 func TestFixedSubcriptionFixtures(t *testing.T) {
-	assert.Equal(t, "active", fixedSubscriptions.FixedSubscriptionStatusActive)
-	assert.Equal(t, "pending", fixedSubscriptions.FixedSubscriptionStatusPending)
-	assert.Equal(t, "error", fixedSubscriptions.FixedSubscriptionStatusError)
-	assert.Equal(t, "deleting", fixedSubscriptions.FixedSubscriptionStatusDeleting)
+	assert.Equal(t, "active", string(fixedSubscriptions.FixedSubscriptionStatusActive))
+	assert.Equal(t, "pending", string(fixedSubscriptions.FixedSubscriptionStatusPending))
+	assert.Equal(t, "error", string(fixedSubscriptions.FixedSubscriptionStatusError))
+	assert.Equal(t, "deleting", string(fixedSubscriptions.FixedSubscriptionStatusDeleting))
 
 	assert.Equal(t, []string{"redis", "memcached", "stack"}, fixedDatabases.ProtocolValues())
 }
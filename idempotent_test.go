@@ -0,0 +1,316 @@
+package rediscloud_api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listDatabasesRequest returns the first page of the databases of subscription 42.
+func listDatabasesRequest(t *testing.T, databases string) endpointRequest {
+	return getRequestWithQuery(t, "/subscriptions/42/databases", map[string][]string{"limit": {"100"}, "offset": {"0"}}, `{
+  "accountId": 2,
+  "subscription": [{"subscriptionId": 42, "databases": `+databases+`}]
+}`)
+}
+
+func TestDatabase_CreateIdempotent_returnsExistingDatabase(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		listDatabasesRequest(t, `[{"databaseId": 1, "name": "other"}, {"databaseId": 2, "name": "example"}]`)))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Database.CreateIdempotent(context.TODO(), 42, databases.CreateDatabase{Name: redis.String("example")})
+	require.NoError(t, err)
+	assert.Equal(t, 2, actual)
+}
+
+func TestDatabase_CreateIdempotent_createsMissingDatabase(t *testing.T) {
+	requests := []endpointRequest{
+		listDatabasesRequest(t, `[{"databaseId": 1, "name": "other"}]`),
+		getRequestWithQueryAndStatus(t, "/subscriptions/42/databases", map[string][]string{"limit": {"100"}, "offset": {"100"}}, 404, ""),
+	}
+	requests = append(requests,
+		postRequest(t, "/subscriptions/42/databases", `{"name": "example"}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", `{"taskId": "task", "status": "processing-completed", "response": {"resourceId": 3}}`),
+	)
+	s := httptest.NewServer(testServer("key", "secret", requests...))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Database.CreateIdempotent(context.TODO(), 42, databases.CreateDatabase{Name: redis.String("example")})
+	require.NoError(t, err)
+	assert.Equal(t, 3, actual)
+}
+
+func TestDatabase_CreateIdempotent_waitsForTaskOfTimedOutAttempt(t *testing.T) {
+	requests := []endpointRequest{listDatabasesRequest(t, `[]`)}
+	requests = append(requests,
+		postRequest(t, "/subscriptions/42/databases", `{"name": "example"}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", `{"taskId": "task", "status": "processing-in-progress"}`),
+		// The retry waits for the same task, rather than creating the database again
+		getRequest(t, "/tasks/task", `{"taskId": "task", "status": "processing-completed", "response": {"resourceId": 3}}`),
+	)
+	s := httptest.NewServer(testServer("key", "secret", requests...))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 200*time.Millisecond)
+	defer cancel()
+	_, err = subject.Database.CreateIdempotent(ctx, 42, databases.CreateDatabase{Name: redis.String("example")})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	actual, err := subject.Database.CreateIdempotent(context.TODO(), 42, databases.CreateDatabase{Name: redis.String("example")})
+	require.NoError(t, err)
+	assert.Equal(t, 3, actual)
+}
+
+func TestDatabase_CreateIdempotent_keepsNameReservedWithNoWait(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		listDatabasesRequest(t, `[]`),
+		postRequest(t, "/subscriptions/42/databases", `{"name": "example"}`, `{"taskId": "task", "status": "received"}`),
+		// The next call waits for the task left processing, rather than creating the database again
+		getRequest(t, "/tasks/task", `{"taskId": "task", "status": "processing-completed", "response": {"resourceId": 3}}`),
+	))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	ctx := WithCallOptions(context.TODO(), CallNoWait(nil))
+	_, err = subject.Database.CreateIdempotent(ctx, 42, databases.CreateDatabase{Name: redis.String("example")})
+	var notWaited *NotWaitedError
+	require.ErrorAs(t, err, &notWaited)
+	assert.Equal(t, "task", notWaited.TaskID)
+
+	actual, err := subject.Database.CreateIdempotent(context.TODO(), 42, databases.CreateDatabase{Name: redis.String("example")})
+	require.NoError(t, err)
+	assert.Equal(t, 3, actual)
+}
+
+func TestDatabase_CreateIdempotent_createsAgainAfterFailedTask(t *testing.T) {
+	requests := []endpointRequest{listDatabasesRequest(t, `[]`)}
+	requests = append(requests,
+		postRequest(t, "/subscriptions/42/databases", `{"name": "example"}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", `{"taskId": "task", "status": "processing-error", "response": {"error": {"type": "DATABASE_LIMIT", "status": "400 BAD_REQUEST", "description": "Too many databases."}}}`),
+	)
+	requests = append(requests, listDatabasesRequest(t, `[]`))
+	requests = append(requests,
+		postRequest(t, "/subscriptions/42/databases", `{"name": "example"}`, `{"taskId": "second", "status": "received"}`),
+		getRequest(t, "/tasks/second", `{"taskId": "second", "status": "processing-completed", "response": {"resourceId": 3}}`),
+	)
+	s := httptest.NewServer(testServer("key", "secret", requests...))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	_, err = subject.Database.CreateIdempotent(context.TODO(), 42, databases.CreateDatabase{Name: redis.String("example")})
+	require.ErrorContains(t, err, "Too many databases.")
+
+	actual, err := subject.Database.CreateIdempotent(context.TODO(), 42, databases.CreateDatabase{Name: redis.String("example")})
+	require.NoError(t, err)
+	assert.Equal(t, 3, actual)
+}
+
+func TestDatabase_CreateIdempotent_findsDatabaseCreatedByLostRequest(t *testing.T) {
+	requests := []endpointRequest{listDatabasesRequest(t, `[]`)}
+	requests = append(requests,
+		postRequestWithStatus(t, "/subscriptions/42/databases", `{"name": "example"}`, http.StatusConflict, `{"description": "Database name already in use"}`))
+	requests = append(requests, listDatabasesRequest(t, `[{"databaseId": 3, "name": "example"}]`))
+	s := httptest.NewServer(testServer("key", "secret", requests...))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Database.CreateIdempotent(context.TODO(), 42, databases.CreateDatabase{Name: redis.String("example")})
+	require.NoError(t, err)
+	assert.Equal(t, 3, actual)
+}
+
+func TestDatabase_CreateIdempotent_concurrentCallsCreateOnce(t *testing.T) {
+	s, posts := concurrentCreateServer(t, "/subscriptions/42/databases", http.StatusOK)
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	ids, errs := createConcurrently(5, func() (int, error) {
+		return subject.Database.CreateIdempotent(context.TODO(), 42, databases.CreateDatabase{Name: redis.String("example")})
+	})
+	assert.Equal(t, []int{3, 3, 3, 3, 3}, ids)
+	assert.Equal(t, make([]error, 5), errs)
+	assert.Equal(t, int32(1), posts.Load())
+}
+
+func TestDatabase_CreateIdempotent_concurrentCallsShareLostResponse(t *testing.T) {
+	s, posts := concurrentCreateServer(t, "/subscriptions/42/databases", http.StatusBadGateway)
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	_, errs := createConcurrently(5, func() (int, error) {
+		return subject.Database.CreateIdempotent(context.TODO(), 42, databases.CreateDatabase{Name: redis.String("example")})
+	})
+	for _, err := range errs {
+		assert.Error(t, err)
+	}
+	assert.Equal(t, int32(1), posts.Load())
+}
+
+func TestDatabase_CreateIdempotent_needsName(t *testing.T) {
+	subject, err := NewClient(Auth("key", "secret"))
+	require.NoError(t, err)
+
+	_, err = subject.Database.CreateIdempotent(context.TODO(), 42, databases.CreateDatabase{})
+	assert.ErrorIs(t, err, databases.ErrNoIdempotencyKey)
+}
+
+func TestSubscription_CreateIdempotent(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		getRequest(t, "/subscriptions", `{"subscriptions": [{"id": 1, "name": "other"}]}`),
+		postRequest(t, "/subscriptions", `{"name": "example"}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", `{"taskId": "task", "status": "processing-completed", "response": {"resourceId": 7}}`),
+		getRequest(t, "/subscriptions", `{"subscriptions": [{"id": 1, "name": "other"}, {"id": 7, "name": "example"}]}`),
+	))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Subscription.CreateIdempotent(context.TODO(), subscriptions.CreateSubscription{Name: redis.String("example")})
+	require.NoError(t, err)
+	assert.Equal(t, 7, actual)
+
+	actual, err = subject.Subscription.CreateIdempotent(context.TODO(), subscriptions.CreateSubscription{Name: redis.String("example")})
+	require.NoError(t, err)
+	assert.Equal(t, 7, actual)
+}
+
+func TestSubscription_CreateIdempotent_concurrentCallsCreateOnce(t *testing.T) {
+	s, posts := concurrentCreateServer(t, "/subscriptions", http.StatusOK)
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	ids, errs := createConcurrently(5, func() (int, error) {
+		return subject.Subscription.CreateIdempotent(context.TODO(), subscriptions.CreateSubscription{Name: redis.String("example")})
+	})
+	assert.Equal(t, []int{3, 3, 3, 3, 3}, ids)
+	assert.Equal(t, make([]error, 5), errs)
+	assert.Equal(t, int32(1), posts.Load())
+}
+
+func TestSubscription_CreateIdempotent_keepsNameReservedWithNoWait(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		getRequest(t, "/subscriptions", `{"subscriptions": []}`),
+		postRequest(t, "/subscriptions", `{"name": "example"}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", `{"taskId": "task", "status": "processing-completed", "response": {"resourceId": 7}}`),
+	))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	ctx := WithCallOptions(context.TODO(), CallNoWait(nil))
+	_, err = subject.Subscription.CreateIdempotent(ctx, subscriptions.CreateSubscription{Name: redis.String("example")})
+	var notWaited *NotWaitedError
+	require.ErrorAs(t, err, &notWaited)
+
+	actual, err := subject.Subscription.CreateIdempotent(context.TODO(), subscriptions.CreateSubscription{Name: redis.String("example")})
+	require.NoError(t, err)
+	assert.Equal(t, 7, actual)
+}
+
+func TestSubscription_CreateIdempotent_concurrentCallsShareLostResponse(t *testing.T) {
+	s, posts := concurrentCreateServer(t, "/subscriptions", http.StatusBadGateway)
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	_, errs := createConcurrently(5, func() (int, error) {
+		return subject.Subscription.CreateIdempotent(context.TODO(), subscriptions.CreateSubscription{Name: redis.String("example")})
+	})
+	for _, err := range errs {
+		assert.Error(t, err)
+	}
+	assert.Equal(t, int32(1), posts.Load())
+}
+
+func TestSubscription_CreateIdempotent_refusesAmbiguousName(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret",
+		getRequest(t, "/subscriptions", `{"subscriptions": [{"id": 1, "name": "example"}, {"id": 2, "name": "example"}]}`)))
+	defer s.Close()
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	_, err = subject.Subscription.CreateIdempotent(context.TODO(), subscriptions.CreateSubscription{Name: redis.String("example")})
+	assert.ErrorContains(t, err, `subscriptions [1 2] are all named "example"`)
+}
+
+// concurrentCreateServer serves creating a resource at path, which no resource has been created at yet, counting the
+// create requests, which are answered with the given status. Creating is slow, so concurrent callers all get the
+// chance to send their own.
+func concurrentCreateServer(t *testing.T, path string, status int) (*httptest.Server, *atomic.Int32) {
+	var posts atomic.Int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1"+path:
+			if path == "/subscriptions" {
+				_, _ = w.Write([]byte(`{"subscriptions": []}`))
+			} else {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case r.Method == http.MethodPost && r.URL.Path == "/v1"+path:
+			posts.Add(1)
+			time.Sleep(100 * time.Millisecond)
+			if status != http.StatusOK {
+				// The request was accepted, but its response is lost
+				w.WriteHeader(status)
+				return
+			}
+			_, _ = w.Write([]byte(`{"taskId": "task", "status": "received"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/tasks/task":
+			_, _ = w.Write([]byte(`{"taskId": "task", "status": "processing-completed", "response": {"resourceId": 3}}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})), &posts
+}
+
+// createConcurrently makes n calls to create at once, and returns the identifiers and errors they return.
+func createConcurrently(n int, create func() (int, error)) ([]int, []error) {
+	ids, errs := make([]int, n), make([]error, n)
+	var wg sync.WaitGroup
+	for i := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ids[i], errs[i] = create()
+		}()
+	}
+	wg.Wait()
+	return ids, errs
+}
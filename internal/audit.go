@@ -0,0 +1,153 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// AuditEvent records a request changing resources: a POST, PUT or DELETE sent to the API.
+type AuditEvent struct {
+	Time time.Time `json:"time"`
+	// Operation describes the request, e.g. "create subscription"
+	Operation string `json:"operation"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	// Request is the body of the request, with its secrets redacted
+	Request json.RawMessage `json:"request,omitempty"`
+	// TaskID is the task the API processed the request with, if any
+	TaskID string `json:"taskId,omitempty"`
+	// Status is AuditSucceeded or AuditFailed, once the request and its task have been processed, or AuditAccepted
+	// when its task wasn't waited for
+	Status     string `json:"status"`
+	HTTPStatus int    `json:"httpStatus,omitempty"`
+	Error      string `json:"error,omitempty"`
+	// Duration is the time from sending the request to its task being processed
+	Duration time.Duration `json:"duration"`
+	// Caller is the identity set on the context of the request with WithCaller
+	Caller string `json:"caller,omitempty"`
+}
+
+const (
+	AuditSucceeded = "succeeded"
+	AuditFailed    = "failed"
+	// AuditAccepted is the status of requests whose task wasn't waited for, as set with CallOptions.NoWait
+	AuditAccepted = "accepted"
+)
+
+// AuditSink receives the audit events of the client.
+type AuditSink interface {
+	Record(ctx context.Context, event AuditEvent) error
+}
+
+type callerKey struct{}
+
+// WithCaller sets the identity recorded in the audit events of the requests made with ctx.
+func WithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// Caller returns the identity set with WithCaller.
+func Caller(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey{}).(string)
+	return caller
+}
+
+// Auditor turns the mutating requests of a client into audit events. Events of requests processed by a task are
+// held until the task has been waited for, as every method of the SDK does, so that they have its outcome.
+type Auditor struct {
+	sink     AuditSink
+	redactor *Redactor
+	logger   Log
+
+	mu      sync.Mutex
+	pending map[string]*pendingAudit
+}
+
+type pendingAudit struct {
+	event AuditEvent
+	start time.Time
+}
+
+func NewAuditor(sink AuditSink, redactor *Redactor, logger Log) *Auditor {
+	return &Auditor{sink: sink, redactor: redactor, logger: logger, pending: map[string]*pendingAudit{}}
+}
+
+func isMutating(method string) bool {
+	return method == http.MethodPost || method == http.MethodPut || method == http.MethodDelete
+}
+
+// requested records a mutating request once the API has answered it, or the request failed.
+func (a *Auditor) requested(ctx context.Context, start time.Time, method, name, path string, requestBody interface{}, responseBody interface{}, err error) {
+	event := AuditEvent{
+		Time:      start.UTC(),
+		Operation: name,
+		Method:    method,
+		Path:      path,
+		Caller:    Caller(ctx),
+	}
+	if requestBody != nil {
+		if data, err := json.Marshal(requestBody); err == nil {
+			if redacted, err := a.redactor.RedactJSON(data); err == nil {
+				event.Request = redacted
+			}
+		}
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		event.HTTPStatus = httpErr.StatusCode
+	} else if err == nil {
+		event.HTTPStatus = http.StatusOK
+	}
+
+	if task, ok := responseBody.(*TaskResponse); ok && err == nil && task.ID != nil {
+		event.TaskID = *task.ID
+		if CallOptionsFrom(ctx).NoWait {
+			event.Duration = time.Since(start)
+			event.Status = AuditAccepted
+			a.write(ctx, event)
+			return
+		}
+		a.mu.Lock()
+		a.pending[event.TaskID] = &pendingAudit{event: event, start: start}
+		a.mu.Unlock()
+		return
+	}
+
+	a.record(ctx, event, start, err)
+}
+
+// finished records the outcome of the request which started a task, once it has been processed.
+func (a *Auditor) finished(ctx context.Context, taskID string, err error) {
+	a.mu.Lock()
+	pending, ok := a.pending[taskID]
+	delete(a.pending, taskID)
+	a.mu.Unlock()
+
+	if ok {
+		a.record(ctx, pending.event, pending.start, err)
+	}
+}
+
+func (a *Auditor) record(ctx context.Context, event AuditEvent, start time.Time, err error) {
+	event.Duration = time.Since(start)
+	event.Status = AuditSucceeded
+	if err != nil {
+		event.Status = AuditFailed
+		event.Error = a.redactor.Redact(err.Error())
+	}
+
+	a.write(ctx, event)
+}
+
+func (a *Auditor) write(ctx context.Context, event AuditEvent) {
+	if err := a.sink.Record(ctx, event); err != nil {
+		LogAttrs(ctx, a.logger, slog.LevelError, "failed to record audit event: "+err.Error(),
+			Operation(event.Operation), slog.String("path", event.Path), slog.String("task_id", event.TaskID))
+	}
+}
@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// CallOptions change how the requests made with a context are sent, and whether their tasks are waited for.
+type CallOptions struct {
+	// Deadline bounds the requests and the waiting for their tasks, when not zero
+	Deadline time.Time
+	// NoWait leaves tasks processing rather than waiting for them, passing their ID to OnTask if set
+	NoWait bool
+	OnTask func(taskID string)
+	// Retry replaces the client's retrying of requests refused with 429 Too Many Requests, when not nil
+	Retry *RetryPolicy
+	// LogRequests logs the requests and responses, even if the client doesn't
+	LogRequests bool
+	// Headers are added to the requests
+	Headers http.Header
+}
+
+// RetryPolicy sets how requests refused with 429 Too Many Requests are retried. An Attempts of 0 or 1 disables
+// retrying.
+type RetryPolicy struct {
+	Attempts uint
+	Delay    time.Duration
+	MaxDelay time.Duration
+}
+
+type callOptionsKey struct{}
+
+// WithCallOptions sets the options of the calls made with the returned context.
+func WithCallOptions(ctx context.Context, options CallOptions) context.Context {
+	return context.WithValue(ctx, callOptionsKey{}, options)
+}
+
+// CallOptionsFrom returns the options set with WithCallOptions, or none.
+func CallOptionsFrom(ctx context.Context) CallOptions {
+	options, _ := ctx.Value(callOptionsKey{}).(CallOptions)
+	return options
+}
+
+// ClearNoWait returns ctx with NoWait cleared, for calls which need the outcome of their tasks.
+func ClearNoWait(ctx context.Context) context.Context {
+	options := CallOptionsFrom(ctx)
+	if !options.NoWait {
+		return ctx
+	}
+	options.NoWait = false
+	return WithCallOptions(ctx, options)
+}
+
+// NotWaitedError is returned in place of the ID of a resource whose task was left processing, as set with NoWait.
+type NotWaitedError struct {
+	TaskID string
+}
+
+func (e *NotWaitedError) Error() string {
+	return fmt.Sprintf("task %s was left processing, so the ID of its resource isn't known yet", e.TaskID)
+}
+
+// withDeadline bounds ctx by the Deadline of its options.
+func withDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if deadline := CallOptionsFrom(ctx).Deadline; !deadline.IsZero() {
+		return context.WithDeadline(ctx, deadline)
+	}
+	return ctx, func() {}
+}
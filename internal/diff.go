@@ -0,0 +1,277 @@
+package internal
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+)
+
+// FieldChange records a single difference found by a Differ.
+type FieldChange struct {
+	Field     string
+	From      interface{}
+	To        interface{}
+	Sensitive bool
+	WriteOnly bool
+}
+
+// Differ accumulates the changes found while comparing a live resource against a desired one.
+type Differ struct {
+	Changes []*FieldChange
+	prefix  string
+}
+
+// Nested returns a Differ which records its changes into this one, with every field name prefixed.
+func (d *Differ) Nested(prefix string) *Differ {
+	return &Differ{prefix: d.prefix + prefix + "."}
+}
+
+// Merge copies the changes of a nested Differ back into this one.
+func (d *Differ) Merge(nested *Differ) {
+	d.Changes = append(d.Changes, nested.Changes...)
+}
+
+// HasChanges reports whether any changes have been recorded.
+func (d *Differ) HasChanges() bool {
+	return len(d.Changes) > 0
+}
+
+func (d *Differ) record(field string, from, to interface{}, sensitive, writeOnly bool) {
+	d.Changes = append(d.Changes, &FieldChange{
+		Field:     d.prefix + field,
+		From:      from,
+		To:        to,
+		Sensitive: sensitive,
+		WriteOnly: writeOnly,
+	})
+}
+
+// DiffValue returns desired when it is set and differs from live, otherwise nil.
+func DiffValue[T comparable](d *Differ, field string, live, desired *T) *T {
+	if desired == nil {
+		return nil
+	}
+	if live != nil && *live == *desired {
+		return nil
+	}
+	d.record(field, valueOf(live), *desired, false, false)
+	return desired
+}
+
+// DiffSecret behaves like DiffValue, but marks the change as sensitive so the values are never rendered.
+func DiffSecret(d *Differ, field string, live, desired *string) *string {
+	if desired == nil {
+		return nil
+	}
+	if live != nil && *live == *desired {
+		return nil
+	}
+	d.record(field, valueOf(live), *desired, true, false)
+	return desired
+}
+
+// DiffWriteOnly handles values the API never returns: a desired value is always sent, as it cannot be compared.
+func DiffWriteOnly[T any](d *Differ, field string, desired *T, sensitive bool) *T {
+	if desired == nil {
+		return nil
+	}
+	d.record(field, nil, *desired, sensitive, true)
+	return desired
+}
+
+// DiffWriteOnlyOptional behaves like DiffWriteOnly for an Optional desired value: anything but unset is always sent.
+func DiffWriteOnlyOptional[T any](d *Differ, field string, desired redis.Optional[T], sensitive bool) redis.Optional[T] {
+	if desired.IsZero() {
+		return desired
+	}
+	d.record(field, nil, valueOf(desired.Ptr()), sensitive, true)
+	return desired
+}
+
+// DiffStrings compares two string lists regardless of ordering, returning desired when it is set and the contents
+// differ.
+func DiffStrings(d *Differ, field string, live, desired []*string) []*string {
+	if desired == nil {
+		return nil
+	}
+	from, to := sortedStrings(live), sortedStrings(desired)
+	if reflect.DeepEqual(from, to) {
+		return nil
+	}
+	d.record(field, from, to, false, false)
+	return desired
+}
+
+// DiffDeep compares two arbitrary values structurally, returning desired when it is set and differs from live.
+func DiffDeep[T any](d *Differ, field string, live, desired *T) *T {
+	if desired == nil {
+		return nil
+	}
+	if live != nil && reflect.DeepEqual(deref(*live), deref(*desired)) {
+		return nil
+	}
+	var from interface{}
+	if live != nil {
+		from = deref(*live)
+	}
+	d.record(field, from, deref(*desired), false, false)
+	return desired
+}
+
+// DiffNested compares a nested object field by field, ignoring the fields left nil in desired, and returns desired
+// when any of the others differs from live.
+func DiffNested[T any](d *Differ, field string, live, desired *T) *T {
+	if desired == nil {
+		return nil
+	}
+	var from interface{}
+	if live != nil {
+		merged := *live
+		Overlay(&merged, desired)
+		if reflect.DeepEqual(*live, merged) {
+			return nil
+		}
+		from = deref(live)
+	}
+	d.record(field, from, deref(desired), false, false)
+	return desired
+}
+
+func (c FieldChange) String() string {
+	if c.Sensitive {
+		return c.Field + ": (sensitive value changed)"
+	}
+	if c.WriteOnly {
+		return fmt.Sprintf("%s: (not returned by the API) -> %s", c.Field, format(c.To))
+	}
+	return fmt.Sprintf("%s: %s -> %s", c.Field, format(c.From), format(c.To))
+}
+
+func format(v interface{}) string {
+	if v == nil {
+		return "(unset)"
+	}
+	// Typed enums are strings underneath and should read the same as plain strings
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.String {
+		return fmt.Sprintf("%q", rv.String())
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func valueOf[T any](v *T) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+// DiffOptional behaves like DiffDeep for an Optional desired value. Null clears the field, which is only a change when
+// live has something to clear.
+func DiffOptional[T any](d *Differ, field string, live *T, desired redis.Optional[T]) redis.Optional[T] {
+	if desired.IsNull() {
+		if live == nil || isEmpty(reflect.ValueOf(*live)) {
+			return redis.Optional[T]{}
+		}
+		d.record(field, deref(*live), nil, false, false)
+		return desired
+	}
+	if changed := DiffDeep(d, field, live, desired.Ptr()); changed != nil {
+		return redis.Set(*changed)
+	}
+	return redis.Optional[T]{}
+}
+
+// DiffOptionalStrings behaves like DiffStrings for an Optional desired value, with null handled as in DiffOptional.
+func DiffOptionalStrings(d *Differ, field string, live []*string, desired redis.Optional[[]*string]) redis.Optional[[]*string] {
+	if desired.IsNull() {
+		if len(live) == 0 {
+			return redis.Optional[[]*string]{}
+		}
+		d.record(field, sortedStrings(live), nil, false, false)
+		return desired
+	}
+	value, ok := desired.Get()
+	if !ok || DiffStrings(d, field, live, value) == nil {
+		return redis.Optional[[]*string]{}
+	}
+	return desired
+}
+
+func isEmpty(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return rv.IsNil() || isEmpty(rv.Elem())
+	case reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	case reflect.Invalid:
+		return true
+	default:
+		return rv.IsZero()
+	}
+}
+
+func sortedStrings(ss []*string) []string {
+	ret := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if s != nil {
+			ret = append(ret, *s)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// deref follows pointers so that structures made of pointers can be compared and printed by value.
+func deref(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	return derefValue(rv)
+}
+
+func derefValue(rv reflect.Value) interface{} {
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Slice:
+		if rv.IsNil() {
+			return []interface{}{}
+		}
+		ret := make([]interface{}, 0, rv.Len())
+		for i := range rv.Len() {
+			ret = append(ret, derefValue(rv.Index(i)))
+		}
+		return ret
+	case reflect.Struct:
+		ret := map[string]interface{}{}
+		for i := range rv.NumField() {
+			field := rv.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			value := derefValue(rv.Field(i))
+			if value == nil {
+				continue
+			}
+			ret[jsonName(field)] = value
+		}
+		return ret
+	case reflect.Invalid:
+		return nil
+	default:
+		return rv.Interface()
+	}
+}
+
+func jsonName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
@@ -0,0 +1,14 @@
+package internal
+
+import "context"
+
+// WaitForDryRun waits for the task of a request made with DryRun set, unmarshalling its outcome into resource. The
+// API refusing the request is the outcome of the dry run rather than its failure, so its reason is returned as
+// refusal instead of err. The task is waited for even when the call options are set not to, as it holds the outcome.
+func WaitForDryRun(ctx context.Context, wait func(ctx context.Context, id string, resource interface{}) error, taskID string, resource interface{}) (refusal error, err error) {
+	err = wait(ClearNoWait(ctx), taskID, resource)
+	if err != nil && IsTaskFailure(err) {
+		return err, nil
+	}
+	return nil, err
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -30,7 +31,9 @@ type HttpClient struct {
 	retryMaxDelay    time.Duration
 	retryDelay       time.Duration
 	retryMaxAttempts uint
+	validateRequests bool
 	logger           Log
+	auditor          *Auditor
 }
 
 func NewHttpClient(client *http.Client, baseUrl string, logger Log) (*HttpClient, error) {
@@ -51,6 +54,17 @@ func NewHttpClient(client *http.Client, baseUrl string, logger Log) (*HttpClient
 	}, nil
 }
 
+// ValidateRequests enables checking request bodies which implement Validatable before they're sent, so invalid
+// requests fail without contacting the API.
+func (c *HttpClient) ValidateRequests(enable bool) {
+	c.validateRequests = enable
+}
+
+// Audit records every mutating request in an audit event, or stops recording them when auditor is nil.
+func (c *HttpClient) Audit(auditor *Auditor) {
+	c.auditor = auditor
+}
+
 func (c *HttpClient) Get(ctx context.Context, name, path string, responseBody interface{}) error {
 	return c.connectionWithRetries(ctx, http.MethodGet, name, path, nil, nil, responseBody)
 }
@@ -76,19 +90,38 @@ func (c *HttpClient) DeleteWithQuery(ctx context.Context, name, path string, que
 }
 
 func (c *HttpClient) connectionWithRetries(ctx context.Context, method, name, path string, query url.Values, requestBody interface{}, responseBody interface{}) error {
-	return retry.Do(func() error {
+	if validatable, ok := requestBody.(Validatable); ok && c.validateRequests {
+		if err := validatable.Validate(); err != nil {
+			return fmt.Errorf("failed to %s: %w", name, err)
+		}
+	}
+
+	ctx, cancel := withDeadline(ctx)
+	defer cancel()
+
+	policy := RetryPolicy{Attempts: c.retryMaxAttempts, Delay: c.retryDelay, MaxDelay: c.retryMaxDelay}
+	enabled := c.retryEnabled
+	if override := CallOptionsFrom(ctx).Retry; override != nil {
+		policy, enabled = *override, true
+		// retry-go takes no attempts as retrying until the context is done
+		policy.Attempts = max(policy.Attempts, 1)
+	}
+
+	start := time.Now()
+	err := retry.Do(func() error {
 		return c.connection(ctx, method, name, path, query, requestBody, responseBody)
 	},
-		retry.Attempts(c.retryMaxAttempts),
-		retry.Delay(c.retryDelay),
-		retry.MaxDelay(c.retryMaxDelay),
+		retry.Attempts(policy.Attempts),
+		retry.Delay(policy.Delay),
+		retry.MaxDelay(policy.MaxDelay),
 		retry.RetryIf(func(err error) bool {
-			if !c.retryEnabled {
+			if !enabled {
 				return false
 			}
 			var target *HTTPError
 			if errors.As(err, &target) && target.StatusCode == http.StatusTooManyRequests {
-				c.logger.Println("status code 429 received, request will be retried")
+				LogAttrs(ctx, c.logger, slog.LevelWarn, "status code 429 received, request will be retried",
+					Operation(name), HTTPStatus(target.StatusCode))
 				return true
 			}
 			return false
@@ -96,6 +129,11 @@ func (c *HttpClient) connectionWithRetries(ctx context.Context, method, name, pa
 		retry.LastErrorOnly(true),
 		retry.Context(ctx),
 	)
+
+	if c.auditor != nil && isMutating(method) {
+		c.auditor.requested(ctx, start, method, name, path, requestBody, responseBody, err)
+	}
+	return err
 }
 
 func (c *HttpClient) connection(ctx context.Context, method, name, path string, query url.Values, requestBody interface{}, responseBody interface{}) error {
@@ -130,13 +168,26 @@ func (c *HttpClient) connection(ctx context.Context, method, name, path string,
 		return fmt.Errorf("failed to create request to %s: %w", name, err)
 	}
 
+	for key, values := range CallOptionsFrom(ctx).Headers {
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
+
 	// The API expects this entry in the header in all requests.
 	request.Header.Set("Content-Type", "application/json")
 
+	start := time.Now()
 	response, err := c.client.Do(request) //nolint:gosec // G704: URL built from SDK-configured base URL, not untrusted input
 	if err != nil {
+		LogStructured(ctx, c.logger, slog.LevelDebug, "API request failed",
+			Operation(name), slog.String("method", method), slog.String("path", path), Duration(time.Since(start)),
+			slog.Any("error", err))
 		return fmt.Errorf("failed to %s: %w", name, err)
 	}
+	LogStructured(ctx, c.logger, slog.LevelDebug, "API request",
+		Operation(name), slog.String("method", method), slog.String("path", path), HTTPStatus(response.StatusCode),
+		Duration(time.Since(start)))
 
 	remainingLimit := response.Header.Get(headerRateLimitRemaining)
 	if remainingLimit != "" {
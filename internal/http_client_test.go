@@ -2,6 +2,8 @@ package internal
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -93,3 +95,43 @@ func (l *testLogger) Println(v ...interface{}) {
 }
 
 var _ Log = &testLogger{}
+
+func TestHttpClient_Retry_logsWarning(t *testing.T) {
+	count := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if count == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer s.Close()
+
+	logger := &structuredTestLogger{}
+	subject, err := NewHttpClient(s.Client(), s.URL, logger)
+	require.NoError(t, err)
+	subject.retryEnabled = true
+
+	require.NoError(t, subject.Get(context.TODO(), "test get request", "/", nil))
+	assert.Contains(t, logger.entries, "WARN status code 429 received, request will be retried operation=test get request http_status=429")
+}
+
+// structuredTestLogger records the entries written to it, without the duration of requests.
+type structuredTestLogger struct {
+	entries []string
+}
+
+func (l *structuredTestLogger) Println(v ...interface{}) {
+	l.entries = append(l.entries, fmt.Sprint(v...))
+}
+
+func (l *structuredTestLogger) LogAttrs(_ context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	entry := level.String() + " " + msg
+	for _, attr := range attrs {
+		if attr.Key != "duration" {
+			entry += " " + attr.String()
+		}
+	}
+	l.entries = append(l.entries, entry)
+}
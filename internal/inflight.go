@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"context"
+	"sync"
+)
+
+// InFlight remembers the create requests in progress by their idempotency key, so that a create made while another
+// with the same key is in progress, or retried after its caller gave up waiting, e.g. on a timeout, waits for the task
+// already started instead of starting another.
+type InFlight struct {
+	mu           sync.Mutex
+	reservations map[string]*Reservation
+}
+
+// Reservation is the claim of a create request on its idempotency key, held from before the request is sent until its
+// task has been processed.
+type Reservation struct {
+	flight  *InFlight
+	key     string
+	started chan struct{}
+	taskID  string
+	err     error
+	done    bool
+}
+
+// Reserve claims the key for a create request. When another request has already claimed it, its reservation is
+// returned instead, with owner false, for its task to be waited for.
+func (f *InFlight) Reserve(key string) (reservation *Reservation, owner bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if reservation, ok := f.reservations[key]; ok {
+		return reservation, false
+	}
+	if f.reservations == nil {
+		f.reservations = map[string]*Reservation{}
+	}
+	reservation = &Reservation{flight: f, key: key, started: make(chan struct{})}
+	f.reservations[key] = reservation
+	return reservation, true
+}
+
+// Start records the task processing the create request.
+func (r *Reservation) Start(taskID string) {
+	r.flight.mu.Lock()
+	defer r.flight.mu.Unlock()
+	r.taskID = taskID
+	r.close()
+}
+
+// Release gives up the key, e.g. as the create request wasn't sent. Callers waiting for its task are told there is
+// none.
+func (r *Reservation) Release() {
+	r.flight.mu.Lock()
+	defer r.flight.mu.Unlock()
+	if r.flight.reservations[r.key] == r {
+		delete(r.flight.reservations, r.key)
+	}
+	r.close()
+}
+
+// Fail gives up the key as the create request failed, possibly after the API accepted it. Callers waiting for its
+// task are given err rather than sending a request of their own, which could duplicate the one that failed.
+func (r *Reservation) Fail(err error) {
+	r.flight.mu.Lock()
+	r.err = err
+	r.flight.mu.Unlock()
+	r.Release()
+}
+
+func (r *Reservation) close() {
+	if !r.done {
+		r.done = true
+		close(r.started)
+	}
+}
+
+// Task waits for the create request holding the reservation to be sent, and returns its task - or false when it was
+// released without one, and the error it failed with, if any.
+func (r *Reservation) Task(ctx context.Context) (string, bool, error) {
+	select {
+	case <-ctx.Done():
+		return "", false, ctx.Err()
+	case <-r.started:
+	}
+	r.flight.mu.Lock()
+	defer r.flight.mu.Unlock()
+	return r.taskID, r.taskID != "", r.err
+}
+
+// Wait waits for the task of the create request. The reservation is released once the task has been processed, or
+// has failed, but kept for the next attempt when waiting itself failed, e.g. the context was cancelled.
+func (r *Reservation) Wait(ctx context.Context, wait func(ctx context.Context, id string) (int, error)) (int, error) {
+	r.flight.mu.Lock()
+	taskID := r.taskID
+	r.flight.mu.Unlock()
+
+	id, err := wait(ctx, taskID)
+	if err == nil || IsTaskFailure(err) {
+		r.Release()
+	}
+	return id, err
+}
@@ -0,0 +1,265 @@
+// Package jsonschema generates JSON Schemas from Go types, following the same JSON tags as encoding/json.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is the subset of JSON Schema needed to describe the SDK's models.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	ID                   string             `json:"$id,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+
+	// closed is marshalled as `"additionalProperties": false`, which can't be expressed with a *Schema
+	closed bool
+}
+
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	type plain Schema
+	if !s.closed {
+		return json.Marshal((*plain)(s))
+	}
+	return json.Marshal(struct {
+		*plain
+		AdditionalProperties bool `json:"additionalProperties"`
+	}{plain: (*plain)(s)})
+}
+
+// Generator builds schemas, sharing the definitions of named struct types between them.
+//
+// A strict generator describes exactly what the library sends: objects reject unknown properties and strings known
+// to be one of a set of values list them. Otherwise, the schema leaves room for what the API may add later.
+type Generator struct {
+	strict     bool
+	defs       map[string]*Schema
+	enums      map[reflect.Type][]string
+	fieldEnums map[reflect.Type]map[string][]string
+}
+
+func NewGenerator(strict bool) *Generator {
+	return &Generator{
+		strict:     strict,
+		defs:       map[string]*Schema{},
+		enums:      map[reflect.Type][]string{},
+		fieldEnums: map[reflect.Type]map[string][]string{},
+	}
+}
+
+// Enum restricts every value of the string type T to the given values.
+func Enum[T ~string](g *Generator, values []T) {
+	allowed := make([]string, 0, len(values))
+	for _, value := range values {
+		allowed = append(allowed, string(value))
+	}
+	g.enums[reflect.TypeOf(values).Elem()] = allowed
+}
+
+// FieldEnum restricts the values of a string field, or of the strings in a list field, of the struct type of v. The
+// field is named as in JSON.
+func (g *Generator) FieldEnum(v interface{}, field string, values []string) {
+	t := reflect.TypeOf(v)
+	if !hasField(t, field) {
+		panic(fmt.Sprintf("jsonschema: %s has no field %q", t, field))
+	}
+	if g.fieldEnums[t] == nil {
+		g.fieldEnums[t] = map[string][]string{}
+	}
+	g.fieldEnums[t][field] = values
+}
+
+// Generate returns a standalone schema for the type of v, with the given $id and title. Definitions created for
+// earlier schemas are not carried over.
+func (g *Generator) Generate(v interface{}, id, title string) *Schema {
+	g.defs = map[string]*Schema{}
+	root := g.For(reflect.TypeOf(v))
+	schema := &Schema{
+		Schema: draft,
+		ID:     id,
+		Title:  title,
+		Ref:    root.Ref,
+		Defs:   g.defs,
+	}
+	if root.Ref == "" {
+		root.Schema, root.ID, root.Title, root.Defs = draft, id, title, g.defs
+		return root
+	}
+	return schema
+}
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// optional is implemented by redis.Optional, whose value is either null or described by the type returned by Ptr.
+type optional interface {
+	IsZero() bool
+	IsNull() bool
+}
+
+// For returns the schema of t. Named structs are added to the definitions and referred to.
+func (g *Generator) For(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+	if t.Implements(reflect.TypeOf((*optional)(nil)).Elem()) {
+		if ptr, ok := t.MethodByName("Ptr"); ok {
+			return &Schema{AnyOf: []*Schema{g.For(ptr.Type.Out(0)), {Type: "null"}}}
+		}
+	}
+	if reflect.PointerTo(t).Implements(unmarshalerType) {
+		// Custom formats can't be described without knowing them
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return g.withEnum(&Schema{Type: "string"}, g.enums[t])
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: g.For(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.For(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.object(t)
+		}
+		name := defName(t)
+		if _, ok := g.defs[name]; !ok {
+			// Reserve the name first, in case the type refers to itself
+			g.defs[name] = nil
+			g.defs[name] = g.object(t)
+		}
+		return &Schema{Ref: "#/$defs/" + name}
+	default:
+		return &Schema{}
+	}
+}
+
+func (g *Generator) object(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}, closed: g.strict}
+	g.addFields(schema, t)
+	return schema
+}
+
+func (g *Generator) addFields(schema *Schema, t reflect.Type) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, ok := FieldName(field)
+		if !ok {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			g.addFields(schema, embedded)
+			continue
+		}
+		schema.Properties[name] = g.withEnum(g.For(field.Type), g.fieldEnums[t][name])
+	}
+}
+
+// withEnum restricts a string schema, or the strings of an array or nullable schema, to the given values.
+func (g *Generator) withEnum(schema *Schema, values []string) *Schema {
+	if !g.strict || len(values) == 0 {
+		return schema
+	}
+	switch {
+	case schema.Type == "string":
+		schema.Enum = values
+	case schema.Items != nil:
+		g.withEnum(schema.Items, values)
+	case len(schema.AnyOf) > 0:
+		g.withEnum(schema.AnyOf[0], values)
+	}
+	return schema
+}
+
+func hasField(t reflect.Type, name string) bool {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		fieldName, ok := FieldName(field)
+		if ok && fieldName == name {
+			return true
+		}
+		if ok && fieldName == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if hasField(embedded, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FieldName returns the name a struct field has in JSON, or false when it's never marshalled. Embedded structs
+// without a name of their own return an empty name, as their fields are promoted.
+func FieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name := strings.Split(tag, ",")[0]
+	if field.Anonymous && name == "" {
+		t := field.Type
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() == reflect.Struct {
+			return "", true
+		}
+	}
+	if !field.IsExported() {
+		return "", false
+	}
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
+
+func defName(t reflect.Type) string {
+	// Packages are named by their path below service/, as e.g. fixed/databases and databases share a name
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/service/"); i >= 0 {
+		pkg = pkg[i+len("/service/"):]
+	} else if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	pkg = strings.ReplaceAll(pkg, "/", ".")
+	if pkg == "" {
+		return t.Name()
+	}
+	return pkg + "." + t.Name()
+}
@@ -0,0 +1,45 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type example struct {
+	Name    *string                  `json:"name,omitempty"`
+	Sizes   []*float64               `json:"sizes,omitempty"`
+	Alerts  redis.Optional[[]string] `json:"alerts,omitzero"`
+	Created *time.Time               `json:"created,omitempty"`
+	Labels  map[string]string        `json:"labels,omitempty"`
+	Ignored string                   `json:"-"`
+}
+
+func TestGenerate(t *testing.T) {
+	actual, err := json.Marshal(NewGenerator(true).Generate(example{}, "https://example.com/example.json", "Example"))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$id": "https://example.com/example.json",
+  "title": "Example",
+  "$ref": "#/$defs/jsonschema.example",
+  "$defs": {
+    "jsonschema.example": {
+      "type": "object",
+      "properties": {
+        "name": {"type": "string"},
+        "sizes": {"type": "array", "items": {"type": "number"}},
+        "alerts": {"anyOf": [{"type": "array", "items": {"type": "string"}}, {"type": "null"}]},
+        "created": {"type": "string", "format": "date-time"},
+        "labels": {"type": "object", "additionalProperties": {"type": "string"}}
+      },
+      "additionalProperties": false
+    }
+  }
+}`, string(actual))
+}
@@ -1,5 +1,78 @@
 package internal
 
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
 type Log interface {
 	Println(v ...interface{})
 }
+
+// StructuredLog is implemented by loggers taking levels and attributes, which every log entry of the SDK is written
+// to. Other loggers only get the message of the entries they have always been given.
+type StructuredLog interface {
+	LogAttrs(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr)
+}
+
+// LogInfo writes an entry about the lifecycle of a resource, such as waiting for it to be created.
+func LogInfo(ctx context.Context, logger interface{}, msg string, attrs ...slog.Attr) {
+	LogAttrs(ctx, logger, slog.LevelInfo, msg, attrs...)
+}
+
+// LogAttrs writes an entry to a logger of the client, which is either a StructuredLog or only has Println or Printf.
+func LogAttrs(ctx context.Context, logger interface{}, level slog.Level, msg string, attrs ...slog.Attr) {
+	switch logger := logger.(type) {
+	case StructuredLog:
+		logger.LogAttrs(ctx, level, msg, attrs...)
+	case interface{ Println(v ...interface{}) }:
+		logger.Println(msg)
+	case interface {
+		Printf(format string, v ...interface{})
+	}:
+		logger.Printf("%s", msg)
+	}
+}
+
+// LogStructured writes an entry to structured loggers only, for entries other loggers were never given.
+func LogStructured(ctx context.Context, logger interface{}, level slog.Level, msg string, attrs ...slog.Attr) {
+	if logger, ok := logger.(StructuredLog); ok {
+		logger.LogAttrs(ctx, level, msg, attrs...)
+	}
+}
+
+// The attributes of log entries, so that the same value has the same key whichever service logs it.
+
+func Operation(name string) slog.Attr {
+	return slog.String("operation", name)
+}
+
+func SubscriptionID(id int) slog.Attr {
+	return slog.Int("subscription_id", id)
+}
+
+func DatabaseID(id int) slog.Attr {
+	return slog.Int("database_id", id)
+}
+
+func TaskID(id *string) slog.Attr {
+	if id == nil {
+		return slog.String("task_id", "")
+	}
+	return slog.String("task_id", *id)
+}
+
+func HTTPStatus(status int) slog.Attr {
+	return slog.Int("http_status", status)
+}
+
+func Duration(d time.Duration) slog.Attr {
+	return slog.Duration("duration", d)
+}
+
+// ResourceID is the attribute of the identifier of a resource other than a subscription or database, such as
+// `user_id`.
+func ResourceID(resource string, id int) slog.Attr {
+	return slog.Int(resource+"_id", id)
+}
@@ -0,0 +1,49 @@
+package internal
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Overlay copies every field which is set (i.e. not a nil pointer, slice or map) in overrides onto target. Both must
+// be pointers to the same struct type.
+func Overlay(target interface{}, overrides interface{}) {
+	t := reflect.ValueOf(target).Elem()
+	o := reflect.ValueOf(overrides)
+	if o.Kind() == reflect.Ptr {
+		if o.IsNil() {
+			return
+		}
+		o = o.Elem()
+	}
+	for i := range o.NumField() {
+		field := o.Field(i)
+		if !o.Type().Field(i).IsExported() || field.IsZero() {
+			continue
+		}
+		t.Field(i).Set(field)
+	}
+}
+
+// HasJSONField reports whether the dot-separated path is present when v is marshalled to JSON.
+func HasJSONField(v interface{}, path string) bool {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return false
+	}
+	var current interface{}
+	if err := json.Unmarshal(data, &current); err != nil {
+		return false
+	}
+	for _, key := range strings.Split(path, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if current, ok = object[key]; !ok {
+			return false
+		}
+	}
+	return current != nil
+}
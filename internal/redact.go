@@ -0,0 +1,190 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces the values of secrets in logs.
+const Redacted = "REDACTED"
+
+// SecretFields are the JSON properties of the API's models holding secrets, such as passwords, cloud provider keys
+// and certificates. Every field tagged `secret:"true"` is listed, so logged requests hide what String does.
+var SecretFields = []string{
+	"password",
+	"globalPassword",
+	"consolePassword",
+	"accessKeyId",
+	"accessSecretKey",
+	"clientSslCertificate",
+	"clientTlsCertificates",
+	"publicCertificatePEMString",
+	"serverCert",
+}
+
+var (
+	// userinfo matches the credentials in a URI, such as `s3://key:secret@bucket/path`.
+	userinfo = regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9+.-]*://)[^/?#\s@"]+@`)
+	// privateKey matches a PEM encoded private key.
+	privateKey = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----(?s:.*?)-----END [A-Z ]*PRIVATE KEY-----`)
+)
+
+// Redactor hides secrets in the dumps of requests and responses: the values of secret properties of JSON bodies,
+// whatever their depth, along with the credentials of URIs and private keys found anywhere.
+type Redactor struct {
+	fields map[string]bool
+}
+
+// NewRedactor returns a Redactor hiding the SecretFields and the given properties. Properties are matched whatever
+// their case, and with or without underscores, so `global_password` is the same as `globalPassword`.
+func NewRedactor(fields ...string) *Redactor {
+	r := &Redactor{fields: map[string]bool{}}
+	for _, field := range append(append([]string{}, SecretFields...), fields...) {
+		r.fields[normalizeField(field)] = true
+	}
+	return r
+}
+
+func normalizeField(field string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(field))
+}
+
+// Redact hides the secrets of a dumped request or response, whose body is expected on a line of its own as
+// httputil writes it.
+func (r *Redactor) Redact(dump string) string {
+	lines := strings.Split(dump, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")) && json.Valid([]byte(trimmed)) {
+			if redacted, err := r.RedactJSON([]byte(trimmed)); err == nil {
+				lines[i] = string(redacted)
+				continue
+			}
+		}
+		lines[i] = redactText(line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// RedactJSON hides the secrets of a JSON document, keeping the order of its properties.
+func (r *Redactor) RedactJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var out bytes.Buffer
+	if err := r.value(decoder, &out, false); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+func (r *Redactor) value(decoder *json.Decoder, out *bytes.Buffer, secret bool) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	switch token := token.(type) {
+	case json.Delim:
+		if secret {
+			// Every value of a secret list or object is hidden, which is written as a whole
+			if err := skip(decoder); err != nil {
+				return err
+			}
+			writeString(out, Redacted)
+			return nil
+		}
+		return r.composite(decoder, out, token)
+	case nil:
+		out.WriteString("null")
+	case string:
+		if secret {
+			writeString(out, Redacted)
+		} else {
+			writeString(out, redactText(token))
+		}
+	case json.Number:
+		if secret {
+			writeString(out, Redacted)
+		} else {
+			out.WriteString(token.String())
+		}
+	case bool:
+		if secret {
+			writeString(out, Redacted)
+		} else if token {
+			out.WriteString("true")
+		} else {
+			out.WriteString("false")
+		}
+	}
+	return nil
+}
+
+func (r *Redactor) composite(decoder *json.Decoder, out *bytes.Buffer, open json.Delim) error {
+	out.WriteRune(rune(open))
+	for first := true; decoder.More(); first = false {
+		if !first {
+			out.WriteByte(',')
+		}
+		secret := false
+		if open == '{' {
+			key, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+			name, ok := key.(string)
+			if !ok {
+				return errors.New("expected an object key")
+			}
+			writeString(out, name)
+			out.WriteByte(':')
+			secret = r.fields[normalizeField(name)]
+		}
+		if err := r.value(decoder, out, secret); err != nil {
+			return err
+		}
+	}
+	// The closing delimiter
+	closing, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	out.WriteRune(rune(closing.(json.Delim)))
+	return nil
+}
+
+// skip consumes the rest of the list or object whose opening delimiter has just been read.
+func skip(decoder *json.Decoder) error {
+	for depth := 1; depth > 0; {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		if delim, ok := token.(json.Delim); ok {
+			if delim == '{' || delim == '[' {
+				depth++
+			} else {
+				depth--
+			}
+		}
+	}
+	return nil
+}
+
+func writeString(out *bytes.Buffer, s string) {
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(s)
+	// The encoder ends values with a new line
+	out.Truncate(out.Len() - 1)
+}
+
+// redactText hides the credentials of URIs and the private keys in free text.
+func redactText(s string) string {
+	s = userinfo.ReplaceAllString(s, "${1}"+Redacted+"@")
+	return privateKey.ReplaceAllString(s, Redacted)
+}
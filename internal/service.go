@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"math"
 	"net/http"
 	"net/url"
@@ -51,6 +52,10 @@ func NewAPI(client *HttpClient, logger Log) Api {
 }
 
 func (a *api) WaitForResourceId(ctx context.Context, id string) (int, error) {
+	if a.noWait(ctx, id) {
+		return 0, &NotWaitedError{TaskID: id}
+	}
+
 	task, err := a.waitForTaskToComplete(ctx, id)
 	if err != nil {
 		return 0, err
@@ -60,20 +65,49 @@ func (a *api) WaitForResourceId(ctx context.Context, id string) (int, error) {
 }
 
 func (a *api) Wait(ctx context.Context, id string) error {
+	if a.noWait(ctx, id) {
+		return nil
+	}
+
 	_, err := a.waitForTaskToComplete(ctx, id)
 	return err
 }
 
 func (a *api) WaitForResource(ctx context.Context, id string, resource interface{}) error {
+	if a.noWait(ctx, id) {
+		return nil
+	}
+
 	task, err := a.waitForTaskToComplete(ctx, id)
 	if err != nil {
 		return err
 	}
+	if task.Response == nil || task.Response.Resource == nil {
+		return nil
+	}
 
 	return json.Unmarshal(*task.Response.Resource, resource)
 }
 
+// noWait reports whether the call options of ctx leave the task processing, passing it to their OnTask if so.
+func (a *api) noWait(ctx context.Context, id string) bool {
+	options := CallOptionsFrom(ctx)
+	if !options.NoWait {
+		return false
+	}
+
+	LogStructured(ctx, a.logger, slog.LevelDebug, "task not waited for", TaskID(&id))
+	if options.OnTask != nil {
+		options.OnTask(id)
+	}
+	return true
+}
+
 func (a *api) waitForTaskToComplete(ctx context.Context, id string) (*Task, error) {
+	ctx, cancel := withDeadline(ctx)
+	defer cancel()
+
+	start := time.Now()
 	var task *Task
 	notFoundCount := 0
 	err := retry.Do(
@@ -94,7 +128,7 @@ func (a *api) waitForTaskToComplete(ctx context.Context, id string) (*Task, erro
 			}
 
 			if _, ok := processingStates[status]; !ok {
-				return retry.Unrecoverable(fmt.Errorf("task %s failed %s - %s", id, status, redis.StringValue(task.Description)))
+				return retry.Unrecoverable(&taskFailedError{id: id, status: status, description: redis.StringValue(task.Description)})
 			}
 
 			return fmt.Errorf("task %s not processed yet: %s", id, status)
@@ -115,13 +149,19 @@ func (a *api) waitForTaskToComplete(ctx context.Context, id string) (*Task, erro
 			}
 			return true
 		}),
-		retry.LastErrorOnly(true), retry.Context(ctx), retry.OnRetry(func(_ uint, err error) {
-			a.logger.Println(err)
+		retry.LastErrorOnly(true), retry.Context(ctx), retry.OnRetry(func(attempt uint, err error) {
+			LogAttrs(ctx, a.logger, slog.LevelDebug, err.Error(), TaskID(&id), slog.Uint64("attempt", uint64(attempt)+1))
 		}))
+	if a.client.auditor != nil {
+		a.client.auditor.finished(ctx, id, err)
+	}
 	if err != nil {
+		LogStructured(ctx, a.logger, slog.LevelWarn, "task failed", TaskID(&id), Duration(time.Since(start)),
+			slog.Any("error", err))
 		return task, err
 	}
 
+	LogStructured(ctx, a.logger, slog.LevelInfo, "task completed", TaskID(&id), Duration(time.Since(start)))
 	return task, nil
 }
 
@@ -157,6 +197,24 @@ var processingStates = map[string]bool{
 
 const processedState = "processing-completed"
 
+// taskFailedError is returned when a Task ends in an error state without describing the error.
+type taskFailedError struct {
+	id          string
+	status      string
+	description string
+}
+
+func (e *taskFailedError) Error() string {
+	return fmt.Sprintf("task %s failed %s - %s", e.id, e.status, e.description)
+}
+
+// IsTaskFailure reports whether err is the failure of a Task, rather than a failure to wait for it.
+func IsTaskFailure(err error) bool {
+	var apiErr *Error
+	var failed *taskFailedError
+	return errors.As(err, &apiErr) || errors.As(err, &failed)
+}
+
 type taskNotFoundError struct {
 	wrapped error
 }
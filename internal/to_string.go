@@ -3,12 +3,74 @@ package internal
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"strings"
+	"sync"
 )
 
+// ToString returns the JSON form of a model for its String method. The values of fields tagged `secret:"true"`, at
+// any depth, are masked along with the credentials of URIs, so that models can be printed and logged safely.
 func ToString(o interface{}) string {
 	output, err := json.Marshal(o)
 	if err != nil {
-		return fmt.Sprintf("%#v", o)
+		// The fields themselves aren't printed, as they may hold secrets
+		return fmt.Sprintf("%T(%s)", o, err)
+	}
+
+	masked, err := (&Redactor{fields: secretFieldsOf(reflect.TypeOf(o))}).RedactJSON(output)
+	if err != nil {
+		return fmt.Sprintf("%T(%s)", o, err)
+	}
+	return string(masked)
+}
+
+// secretFields caches the normalised JSON names of the secret fields of each type.
+var secretFields sync.Map
+
+// secretFieldsOf returns the JSON names of the fields tagged `secret:"true"` in a type or the types it's made of.
+func secretFieldsOf(t reflect.Type) map[string]bool {
+	if t == nil {
+		return nil
+	}
+	if cached, ok := secretFields.Load(t); ok {
+		return cached.(map[string]bool)
+	}
+
+	fields := map[string]bool{}
+	collectSecretFields(t, fields, map[reflect.Type]bool{})
+	secretFields.Store(t, fields)
+	return fields
+}
+
+func collectSecretFields(t reflect.Type, fields map[string]bool, seen map[reflect.Type]bool) {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || seen[t] {
+		return
+	}
+	seen[t] = true
+
+	// Optional values hold theirs in an unexported field, which is still what is sent
+	if get, ok := t.MethodByName("Get"); ok && get.Type.NumOut() == 2 {
+		collectSecretFields(get.Type.Out(0), fields, seen)
+	}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if field.Tag.Get("secret") == "true" {
+			fields[normalizeField(name)] = true
+		}
+		collectSecretFields(field.Type, fields, seen)
 	}
-	return string(output)
 }
@@ -0,0 +1,43 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCredentials struct {
+	Name     *string `json:"name,omitempty"`
+	Password *string `json:"password,omitempty" secret:"true"`
+}
+
+type testAccount struct {
+	Name        *string                           `json:"name,omitempty"`
+	Credentials []*testCredentials                `json:"credentials,omitempty"`
+	Keys        redis.Optional[[]testCredentials] `json:"keys,omitzero"`
+	APIToken    string                            `json:"apiToken" secret:"true"`
+	BackupURI   *string                           `json:"backupUri,omitempty"`
+}
+
+func TestToString_masksSecretFields(t *testing.T) {
+	account := testAccount{
+		Name:        redis.String("example"),
+		Credentials: []*testCredentials{{Name: redis.String("admin"), Password: redis.String("hunter2")}},
+		Keys:        redis.Set([]testCredentials{{Password: redis.String("key")}}),
+		APIToken:    "token",
+		BackupURI:   redis.String("s3://key:secret@bucket/backup"),
+	}
+
+	assert.Equal(t,
+		`{"name":"example","credentials":[{"name":"admin","password":"REDACTED"}],"keys":[{"password":"REDACTED"}],"apiToken":"REDACTED","backupUri":"s3://REDACTED@bucket/backup"}`,
+		ToString(account))
+}
+
+func TestToString_keepsUnsetSecrets(t *testing.T) {
+	assert.Equal(t, `{"name":"admin"}`, ToString(&testCredentials{Name: redis.String("admin")}))
+}
+
+func TestToString_doesNotPrintFieldsOnError(t *testing.T) {
+	assert.Equal(t, "chan int(json: unsupported type: chan int)", ToString(make(chan int)))
+}
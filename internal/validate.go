@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+)
+
+// Validatable is implemented by request types which can check themselves before being sent.
+type Validatable interface {
+	Validate() error
+}
+
+// ValidationError lists every problem found with a request, so they can all be fixed at once rather than one API
+// round trip at a time.
+type ValidationError struct {
+	Request  string
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Request, strings.Join(e.Problems, "; "))
+}
+
+// Validation collects the problems found while validating a request. Fields are referred to by their JSON names.
+type Validation struct {
+	request  string
+	problems []string
+}
+
+func NewValidation(request string) *Validation {
+	return &Validation{request: request}
+}
+
+// Err returns a *ValidationError when any problems were found, otherwise nil.
+func (v *Validation) Err() error {
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Request: v.request, Problems: v.problems}
+}
+
+// Merge records the problems of err which haven't been recorded already, each prefixed with the field they were found
+// under, when it isn't empty. Errors other than *ValidationError are recorded as a single problem.
+func (v *Validation) Merge(field string, err error) {
+	if err == nil {
+		return
+	}
+	problems := []string{err.Error()}
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		problems = validationErr.Problems
+	}
+	for _, problem := range problems {
+		if field != "" {
+			problem = field + "." + problem
+		}
+		if !slices.Contains(v.problems, problem) {
+			v.problems = append(v.problems, problem)
+		}
+	}
+}
+
+// Addf records a problem which doesn't fit any of the other checks.
+func (v *Validation) Addf(format string, args ...interface{}) {
+	v.problems = append(v.problems, fmt.Sprintf(format, args...))
+}
+
+// Required records a problem when a required field isn't set.
+func (v *Validation) Required(field string, set bool) {
+	if !set {
+		v.Addf("%s is required", field)
+	}
+}
+
+// OneOf records a problem when a set field isn't one of the allowed values.
+func OneOf[T ~string](v *Validation, field string, value *T, allowed []string) {
+	if value != nil && !slices.Contains(allowed, string(*value)) {
+		v.Addf("%s must be one of %s, got %q", field, strings.Join(allowed, ", "), *value)
+	}
+}
+
+// Exclusive records a problem when more than one of the fields, keyed by name to whether they're set, is set.
+func (v *Validation) Exclusive(fields map[string]bool) {
+	var set []string
+	for field, isSet := range fields {
+		if isSet {
+			set = append(set, field)
+		}
+	}
+	if len(set) > 1 {
+		slices.Sort(set)
+		v.Addf("only one of %s may be set", strings.Join(set, ", "))
+	}
+}
+
+// CIDR records a problem when a set field isn't a valid CIDR block.
+func (v *Validation) CIDR(field string, value *string) {
+	if value == nil {
+		return
+	}
+	if _, _, err := net.ParseCIDR(*value); err != nil {
+		v.Addf("%s must be a CIDR block, got %q", field, *value)
+	}
+}
+
+// CIDRs records a problem for every entry of the list which isn't a valid CIDR block.
+func (v *Validation) CIDRs(field string, values []*string) {
+	for i, value := range values {
+		v.CIDR(fmt.Sprintf("%s[%d]", field, i), value)
+	}
+}
+
+// SourceIPs records a problem for every entry of the list which is neither an IP address nor a CIDR block.
+func (v *Validation) SourceIPs(field string, values []*string) {
+	for i, value := range values {
+		if value == nil || net.ParseIP(*value) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(*value); err != nil {
+			v.Addf("%s[%d] must be an IP address or CIDR block, got %q", field, i, *value)
+		}
+	}
+}
+
+// Positive records a problem when a set number isn't greater than zero.
+func Positive[T int | float64](v *Validation, field string, value *T) {
+	if value != nil && *value <= 0 {
+		v.Addf("%s must be greater than 0, got %v", field, *value)
+	}
+}
+
+// InRange records a problem when a set number isn't between min and max, inclusive.
+func InRange[T int | float64](v *Validation, field string, value *T, min, max T) {
+	if value != nil && (*value < min || *value > max) {
+		v.Addf("%s must be between %v and %v, got %v", field, min, max, *value)
+	}
+}
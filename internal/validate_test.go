@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidation_collectsEveryProblem(t *testing.T) {
+	port, size, cidr, policy := 80, 0.0, "10.0.0.0/8", "other"
+
+	v := NewValidation("example request")
+	v.Required("name", false)
+	OneOf(v, "policy", &policy, []string{"a", "b"})
+	v.Exclusive(map[string]bool{"memory": true, "dataset": true, "other": false})
+	v.CIDR("cidr", &cidr)
+	v.CIDRs("cidrs", []*string{&cidr, &policy})
+	Positive(v, "size", &size)
+	InRange(v, "port", &port, 10000, 19999)
+
+	err := v.Err()
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []string{
+		"name is required",
+		`policy must be one of a, b, got "other"`,
+		"only one of dataset, memory may be set",
+		`cidrs[1] must be a CIDR block, got "other"`,
+		"size must be greater than 0, got 0",
+		"port must be between 10000 and 19999, got 80",
+	}, validationErr.Problems)
+	assert.Equal(t, `invalid example request: name is required; policy must be one of a, b, got "other"; only one of dataset, memory may be set; cidrs[1] must be a CIDR block, got "other"; size must be greater than 0, got 0; port must be between 10000 and 19999, got 80`, err.Error())
+}
+
+func TestValidation_unsetFieldsAreValid(t *testing.T) {
+	v := NewValidation("example request")
+	OneOf[string](v, "policy", nil, []string{"a"})
+	v.CIDR("cidr", nil)
+	Positive[int](v, "size", nil)
+	InRange[float64](v, "ratio", nil, 0, 1)
+
+	assert.NoError(t, v.Err())
+}
+
+func TestValidation_Merge(t *testing.T) {
+	nested := NewValidation("nested request")
+	nested.Required("name", false)
+
+	v := NewValidation("example request")
+	v.Required("databases[0].name", false)
+	v.Merge("databases[0]", nested.Err())
+	v.Merge("", errors.New("something else"))
+	v.Merge("", nil)
+
+	assert.EqualError(t, v.Err(), "invalid example request: databases[0].name is required; something else")
+}
+
+func TestValidation_SourceIPs(t *testing.T) {
+	ip, ipv6, cidr, other := "10.0.0.1", "2001:db8::1", "10.0.0.0/8", "10.0.0.1/33"
+
+	v := NewValidation("example request")
+	v.SourceIPs("sourceIp", []*string{&ip, &ipv6, &cidr, nil, &other})
+
+	assert.EqualError(t, v.Err(), `invalid example request: sourceIp[4] must be an IP address or CIDR block, got "10.0.0.1/33"`)
+}
@@ -0,0 +1,65 @@
+package rediscloud_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogHandler_writesStructuredEntries(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret", taskFlow(t, http.MethodPost, "/subscriptions/42/databases/18/backup", "", "task-id", "databaseBackupRequest")...))
+	defer s.Close()
+
+	var out bytes.Buffer
+	handler := slog.NewJSONHandler(&out, &slog.HandlerOptions{Level: slog.LevelDebug})
+	subject, err := NewClient(BaseURL(s.URL+"/v1"), Auth("key", "secret"), Transporter(s.Client().Transport), LogHandler(handler))
+	require.NoError(t, err)
+
+	require.NoError(t, subject.Database.Backup(context.TODO(), 42, 18))
+
+	var entries []map[string]interface{}
+	decoder := json.NewDecoder(&out)
+	for decoder.More() {
+		var entry map[string]interface{}
+		require.NoError(t, decoder.Decode(&entry))
+		delete(entry, "time")
+		delete(entry, "duration")
+		entries = append(entries, entry)
+	}
+
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"level": "DEBUG", "msg": "API request", "operation": "backup database 18 for subscription 42",
+			"method": "POST", "path": "/subscriptions/42/databases/18/backup", "http_status": float64(200),
+		},
+		{
+			"level": "INFO", "msg": "Waiting for backup of database 18 for subscription 42 to finish",
+			"database_id": float64(18), "subscription_id": float64(42),
+		},
+		{
+			"level": "DEBUG", "msg": "API request", "operation": "retrieve Task task-id",
+			"method": "GET", "path": "/tasks/task-id", "http_status": float64(200),
+		},
+		{"level": "INFO", "msg": "task completed", "task_id": "task-id"},
+	}, entries)
+}
+
+func TestLogHandler_respectsLevel(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret", taskFlow(t, http.MethodPost, "/subscriptions/42/databases/18/backup", "", "task-id", "databaseBackupRequest")...))
+	defer s.Close()
+
+	var out bytes.Buffer
+	handler := slog.NewTextHandler(&out, &slog.HandlerOptions{Level: slog.LevelWarn})
+	subject, err := NewClient(BaseURL(s.URL+"/v1"), Auth("key", "secret"), Transporter(s.Client().Transport), LogHandler(handler))
+	require.NoError(t, err)
+
+	require.NoError(t, subject.Database.Backup(context.TODO(), 42, 18))
+	assert.Empty(t, out.String())
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/RedisLabs/rediscloud-go-api/redis"
 	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/stretchr/testify/require"
@@ -61,3 +62,39 @@ func TestGet(t *testing.T) {
 		},
 	}, actual)
 }
+
+func TestSubscription_Estimate(t *testing.T) {
+	server := httptest.NewServer(testServer("key", "secret",
+		postRequest(t, "/subscriptions", `{"dryRun": true, "name": "example"}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", dryRunPricing),
+	))
+	defer server.Close()
+
+	subject, err := clientFromTestServer(server, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Subscription.Estimate(context.TODO(), subscriptions.CreateSubscription{Name: redis.String("example")})
+	require.NoError(t, err)
+	assert.Equal(t, expectedDryRunPricing, actual)
+
+	assert.Equal(t, pricing.Costs{{Currency: "USD", Hourly: 0.248, Monthly: 181.04}}, pricing.Total(actual))
+}
+
+func TestSubscription_Estimate_refused(t *testing.T) {
+	server := httptest.NewServer(testServer("key", "secret",
+		postRequest(t, "/subscriptions", `{"dryRun": true, "name": "example"}`, `{"taskId": "task", "status": "received"}`),
+		getRequest(t, "/tasks/task", `{
+		  "taskId": "task",
+		  "status": "processing-error",
+		  "response": {"error": {"type": "SUBSCRIPTION_PI_NOT_FOUND", "status": "404 NOT_FOUND", "description": "Payment info was not found."}}
+		}`),
+	))
+	defer server.Close()
+
+	subject, err := clientFromTestServer(server, "key", "secret")
+	require.NoError(t, err)
+
+	_, err = subject.Subscription.Estimate(context.TODO(), subscriptions.CreateSubscription{Name: redis.String("example")})
+	assert.ErrorContains(t, err, "subscription example would be refused: ")
+	assert.ErrorContains(t, err, "Payment info was not found.")
+}
@@ -97,11 +97,11 @@ func TestGetPrivateLink(t *testing.T) {
 				),
 			},
 			expectedResult: &pl.PrivateLink{
-				Status: redis.String("received"),
+				Status: redis.Ptr(pl.Status("received")),
 				Principals: []*pl.PrivateLinkPrincipal{
 					{
 						Principal: redis.String("arn:aws:iam::123456789012:root"),
-						Status:    redis.String("ready"),
+						Status:    redis.Ptr(pl.PrincipalStatus("ready")),
 						Alias:     redis.String("some alias"),
 						Type:      redis.String("aws_account"),
 					},
@@ -350,11 +350,11 @@ func TestGetActiveActivePrivateLink(t *testing.T) {
 				),
 			},
 			expectedResult: &pl.PrivateLink{
-				Status: redis.String("received"),
+				Status: redis.Ptr(pl.Status("received")),
 				Principals: []*pl.PrivateLinkPrincipal{
 					{
 						Principal: redis.String("arn:aws:iam::123456789012:root"),
-						Status:    redis.String("ready"),
+						Status:    redis.Ptr(pl.PrincipalStatus("ready")),
 						Alias:     redis.String("some alias"),
 						Type:      redis.String("aws_account"),
 					},
@@ -0,0 +1,208 @@
+package rediscloud_api
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultProfile is the profile used when none is selected, if the config file has it.
+const DefaultProfile = "default"
+
+// Profile selects a named profile of the config file, whose credentials, base URL and user agent are used instead of
+// the environment variables - will otherwise default to the profile named by `REDISCLOUD_PROFILE`, if set.
+//
+// The config file, `~/.config/rediscloud/config` unless changed with ConfigFile, lists profiles as:
+//
+//	[default]
+//	api_key = ...
+//	secret_key = ...
+//
+//	[staging]
+//	api_key = ...
+//	secret_key = ...
+//	url = https://api.staging.example.com/v1
+//	user_agent = staging-scripts
+//
+// Without a selected profile, the environment variables take precedence and the `default` profile only provides
+// what they leave unset. Auth and BaseURL always take precedence over profiles.
+func Profile(name string) Option {
+	return func(options *Options) {
+		options.profile = name
+	}
+}
+
+// ConfigFile sets the file profiles are read from - will default to `$XDG_CONFIG_HOME/rediscloud/config`, or
+// `~/.config/rediscloud/config`.
+func ConfigFile(path string) Option {
+	return func(options *Options) {
+		options.configFile = path
+	}
+}
+
+// profile is a section of the config file. Empty fields are unset.
+type profile struct {
+	apiKey    string
+	secretKey string
+	url       string
+	userAgent string
+}
+
+// resolve fills in the settings left unset by options from the environment variables and the config file.
+func (o *Options) resolve() error {
+	name, selected := o.profile, o.profile != ""
+	if !selected {
+		name = os.Getenv(ProfileEnvVar)
+		selected = name != ""
+	}
+	if !selected {
+		name = DefaultProfile
+	}
+
+	fromFile, err := o.loadProfile(name, selected)
+	if err != nil {
+		return err
+	}
+	fromEnv := profile{
+		apiKey:    os.Getenv(AccessKeyEnvVar),
+		secretKey: os.Getenv(SecretKeyEnvVar),
+		url:       os.Getenv(RedisCloudUrlEnvVar),
+	}
+
+	sources := []profile{fromEnv, fromFile}
+	if selected {
+		sources = []profile{fromFile, fromEnv}
+	}
+
+	// The key and secret go together, so they're taken from the same place
+	if o.apiKey == "" && o.secretKey == "" {
+		for _, source := range sources {
+			if source.apiKey != "" || source.secretKey != "" {
+				o.apiKey, o.secretKey = source.apiKey, source.secretKey
+				break
+			}
+		}
+	}
+	for _, source := range sources {
+		if o.baseUrl == "" {
+			o.baseUrl = source.url
+		}
+	}
+	if o.baseUrl == "" {
+		o.baseUrl = defaultBaseUrl
+	}
+	if fromFile.userAgent != "" {
+		o.userAgent += " " + fromFile.userAgent
+	}
+
+	return nil
+}
+
+// loadProfile returns the named profile of the config file. Missing files and profiles are only errors when the
+// profile or the file were asked for.
+func (o *Options) loadProfile(name string, required bool) (profile, error) {
+	path := o.configFile
+	required = required || path != ""
+	if path == "" {
+		var err error
+		path, err = defaultConfigFile()
+		if err != nil {
+			if required {
+				return profile{}, fmt.Errorf("failed to find the config file for profile %q: %w", name, err)
+			}
+			return profile{}, nil
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !required && errors.Is(err, fs.ErrNotExist) {
+			return profile{}, nil
+		}
+		return profile{}, fmt.Errorf("failed to read profile %q: %w", name, err)
+	}
+
+	profiles, err := parseProfiles(path, data)
+	if err != nil {
+		return profile{}, err
+	}
+	found, ok := profiles[name]
+	if !ok && required {
+		return profile{}, fmt.Errorf("profile %q not found in %s", name, path)
+	}
+	return found, nil
+}
+
+func defaultConfigFile() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "rediscloud", "config"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "rediscloud", "config"), nil
+}
+
+// parseProfiles reads the sections of a config file. Blank lines and lines starting with # or ; are ignored.
+func parseProfiles(path string, data []byte) (map[string]profile, error) {
+	profiles := map[string]profile{}
+	var current string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") {
+			if !strings.HasSuffix(text, "]") {
+				return nil, fmt.Errorf("%s:%d: unclosed profile name", path, line)
+			}
+			current = strings.TrimSpace(text[1 : len(text)-1])
+			if current == "" {
+				return nil, fmt.Errorf("%s:%d: empty profile name", path, line)
+			}
+			if _, ok := profiles[current]; ok {
+				return nil, fmt.Errorf("%s:%d: profile %q is defined twice", path, line, current)
+			}
+			profiles[current] = profile{}
+			continue
+		}
+
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected key = value", path, line)
+		}
+		if current == "" {
+			return nil, fmt.Errorf("%s:%d: %s is not in a profile", path, line, strings.TrimSpace(key))
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+
+		p := profiles[current]
+		switch key {
+		case "api_key":
+			p.apiKey = value
+		case "secret_key":
+			p.secretKey = value
+		case "url":
+			p.url = value
+		case "user_agent":
+			p.userAgent = value
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown key %q, expected api_key, secret_key, url or user_agent", path, line, key)
+		}
+		profiles[current] = p
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return profiles, nil
+}
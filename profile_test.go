@@ -0,0 +1,172 @@
+package rediscloud_api
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfig = `
+# Accounts used by the team
+[default]
+api_key = default-key
+secret_key = default-secret
+
+[staging]
+api_key = staging-key
+secret_key = staging-secret
+url = https://staging.example.org/v1
+user_agent = staging-scripts
+`
+
+func withConfig(t *testing.T, content string) string {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rediscloud", "config")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv(AccessKeyEnvVar, "")
+	t.Setenv(SecretKeyEnvVar, "")
+	t.Setenv(RedisCloudUrlEnvVar, "")
+	t.Setenv(ProfileEnvVar, "")
+	return path
+}
+
+func resolved(t *testing.T, configs ...Option) *Options {
+	config := &Options{userAgent: "agent"}
+	for _, option := range configs {
+		option(config)
+	}
+	require.NoError(t, config.resolve())
+	return config
+}
+
+func TestProfile_defaultProfileWithoutEnvironment(t *testing.T) {
+	withConfig(t, testConfig)
+
+	actual := resolved(t)
+	assert.Equal(t, "default-key", actual.apiKey)
+	assert.Equal(t, "default-secret", actual.secretKey)
+	assert.Equal(t, "https://api.redislabs.com/v1", actual.baseUrl)
+	assert.Equal(t, "agent", actual.userAgent)
+}
+
+func TestProfile_environmentBeatsDefaultProfile(t *testing.T) {
+	withConfig(t, testConfig)
+	t.Setenv(AccessKeyEnvVar, "env-key")
+	t.Setenv(SecretKeyEnvVar, "env-secret")
+	t.Setenv(RedisCloudUrlEnvVar, "https://env.example.org/v1")
+
+	actual := resolved(t)
+	assert.Equal(t, "env-key", actual.apiKey)
+	assert.Equal(t, "env-secret", actual.secretKey)
+	assert.Equal(t, "https://env.example.org/v1", actual.baseUrl)
+}
+
+func TestProfile_selectedProfileBeatsEnvironment(t *testing.T) {
+	withConfig(t, testConfig)
+	t.Setenv(AccessKeyEnvVar, "env-key")
+	t.Setenv(SecretKeyEnvVar, "env-secret")
+
+	for name, option := range map[string][]Option{
+		"option":               {Profile("staging")},
+		"environment variable": nil,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if option == nil {
+				t.Setenv(ProfileEnvVar, "staging")
+			}
+			actual := resolved(t, option...)
+			assert.Equal(t, "staging-key", actual.apiKey)
+			assert.Equal(t, "staging-secret", actual.secretKey)
+			assert.Equal(t, "https://staging.example.org/v1", actual.baseUrl)
+			assert.Equal(t, "agent staging-scripts", actual.userAgent)
+		})
+	}
+}
+
+func TestProfile_profileOptionBeatsEnvironmentVariable(t *testing.T) {
+	withConfig(t, testConfig)
+	t.Setenv(ProfileEnvVar, "staging")
+
+	actual := resolved(t, Profile("default"))
+	assert.Equal(t, "default-key", actual.apiKey)
+}
+
+func TestProfile_optionsBeatProfile(t *testing.T) {
+	withConfig(t, testConfig)
+
+	actual := resolved(t, Profile("staging"), Auth("key", "secret"), BaseURL("https://example.org/v1"))
+	assert.Equal(t, "key", actual.apiKey)
+	assert.Equal(t, "secret", actual.secretKey)
+	assert.Equal(t, "https://example.org/v1", actual.baseUrl)
+}
+
+func TestProfile_missingDefaultIsFine(t *testing.T) {
+	withConfig(t, "[staging]\napi_key = key\nsecret_key = secret\n")
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	actual := resolved(t)
+	assert.Empty(t, actual.apiKey)
+	assert.Equal(t, "https://api.redislabs.com/v1", actual.baseUrl)
+}
+
+func TestProfile_configFileOption(t *testing.T) {
+	path := withConfig(t, testConfig)
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	actual := resolved(t, ConfigFile(path), Profile("staging"))
+	assert.Equal(t, "staging-key", actual.apiKey)
+}
+
+func TestProfile_errors(t *testing.T) {
+	for name, test := range map[string]struct {
+		config   string
+		options  []Option
+		expected string
+	}{
+		"unknown profile":   {testConfig, []Option{Profile("prod")}, `profile "prod" not found in %s`},
+		"unknown key":       {"[prod]\napi-key = key\n", nil, `%s:2: unknown key "api-key", expected api_key, secret_key, url or user_agent`},
+		"key outside":       {"api_key = key\n", nil, "%s:1: api_key is not in a profile"},
+		"not a key":         {"[prod]\napi_key\n", nil, "%s:2: expected key = value"},
+		"unclosed section":  {"[prod\n", nil, "%s:1: unclosed profile name"},
+		"empty section":     {"[ ]\n", nil, "%s:1: empty profile name"},
+		"duplicate section": {"[prod]\n\n[prod]\n", nil, `%s:3: profile "prod" is defined twice`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := withConfig(t, test.config)
+			_, err := NewClient(test.options...)
+			assert.EqualError(t, err, fmt.Sprintf(test.expected, path))
+		})
+	}
+}
+
+func TestProfile_missingSelectedConfigFile(t *testing.T) {
+	withConfig(t, testConfig)
+	path := filepath.Join(t.TempDir(), "missing")
+
+	_, err := NewClient(ConfigFile(path))
+	assert.ErrorContains(t, err, `failed to read profile "default"`)
+}
+
+func TestProfile_usedByClient(t *testing.T) {
+	s := httptest.NewServer(testServer("staging-key", "staging-secret", getRequest(t, "/subscriptions", `{"subscriptions": []}`)))
+	defer s.Close()
+
+	withConfig(t, fmt.Sprintf("[staging]\napi_key = staging-key\nsecret_key = staging-secret\nurl = %s/v1\n", s.URL))
+	t.Setenv(ProfileEnvVar, "staging")
+
+	client, err := NewClient()
+	require.NoError(t, err)
+
+	actual, err := client.Subscription.List(context.TODO())
+	require.NoError(t, err)
+	assert.Empty(t, actual)
+}
@@ -74,7 +74,7 @@ func TestGetPrivateServiceConnectService(t *testing.T) {
 				ID:                    redis.Int(40),
 				ConnectionHostName:    redis.String("psc.mc2018-0.us-central1-mz.gcp.sdk-cloud.rlrcp.com"),
 				ServiceAttachmentName: redis.String("service-attachment-mc2018-0-us-central1-mz-rlrcp"),
-				Status:                redis.String("active"),
+				Status:                redis.Ptr(psc.ServiceStatusActive),
 			},
 		},
 		{
@@ -227,7 +227,7 @@ func TestGetPrivateServiceConnectServiceActiveActive(t *testing.T) {
 				ID:                    redis.Int(40),
 				ConnectionHostName:    redis.String("psc.mc2018-0.us-central1-mz.gcp.sdk-cloud.rlrcp.com"),
 				ServiceAttachmentName: redis.String("service-attachment-mc2018-0-us-central1-mz-rlrcp"),
-				Status:                redis.String("active"),
+				Status:                redis.Ptr(psc.ServiceStatusActive),
 			},
 		},
 		{
@@ -574,7 +574,7 @@ func TestGetPrivateServiceConnectEndpoints(t *testing.T) {
 						GCPVPCName:             redis.String("my-vpc"),
 						GCPVPCSubnetName:       redis.String("my-vpc-subnet"),
 						EndpointConnectionName: redis.String("my-endpoint-connection"),
-						Status:                 redis.String("initialized"),
+						Status:                 redis.Ptr(psc.EndpointStatusInitialized),
 					},
 				},
 			},
@@ -740,7 +740,7 @@ func TestGetPrivateServiceConnectEndpointsActiveActive(t *testing.T) {
 						GCPVPCName:             redis.String("my-vpc"),
 						GCPVPCSubnetName:       redis.String("my-vpc-subnet"),
 						EndpointConnectionName: redis.String("my-endpoint-connection"),
-						Status:                 redis.String("initialized"),
+						Status:                 redis.Ptr(psc.EndpointStatusInitialized),
 					},
 				},
 			},
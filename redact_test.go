@@ -0,0 +1,134 @@
+package rediscloud_api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// secretLooking matches the names of properties which hold secrets, which are expected to be hidden from logs.
+var secretLooking = regexp.MustCompile(`(?i)password|secret|certificate|cert$|pem|private.?key|token|accesskey`)
+
+// TestRedact_everyModel fills every property of every model, giving the secret ones a recognisable value, and checks
+// none of those values can be found once the model is logged as a request body.
+func TestRedact_everyModel(t *testing.T) {
+	withSecrets := 0
+	for name, model := range schema.Models() {
+		t.Run(name, func(t *testing.T) {
+			v := reflect.New(reflect.TypeOf(model)).Elem()
+			fill(t, v, "")
+
+			body, err := json.Marshal(v.Interface())
+			require.NoError(t, err)
+			logged := string((&credentialTripper{}).redact([]byte("POST /example HTTP/1.1\r\n\r\n" + string(body))))
+
+			assert.NotContains(t, logged, "SECRET-")
+			if strings.Contains(string(body), "SECRET-") {
+				withSecrets++
+			}
+		})
+	}
+	// Databases, cloud accounts and imports at least have secrets, so the sweep would be pointless without any
+	assert.GreaterOrEqual(t, withSecrets, 10)
+}
+
+// fill sets every field of v to a value, recursively. Secret looking fields get a value starting with SECRET-, and
+// URIs get credentials.
+func fill(t *testing.T, v reflect.Value, name string) {
+	if v.Type() == reflect.TypeOf(time.Time{}) {
+		v.Set(reflect.ValueOf(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+		return
+	}
+	// Optional values are set through their JSON form, as their fields are unexported
+	if get, ok := v.Type().MethodByName("Get"); ok && v.Kind() == reflect.Struct && v.CanAddr() {
+		if unmarshaler, ok := v.Addr().Interface().(json.Unmarshaler); ok {
+			inner := reflect.New(get.Type.Out(0)).Elem()
+			fill(t, inner, name)
+			data, err := json.Marshal(inner.Interface())
+			require.NoError(t, err)
+			require.NoError(t, unmarshaler.UnmarshalJSON(data))
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(t, v.Elem(), name)
+	case reflect.Struct:
+		for i := range v.NumField() {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			tag := strings.Split(field.Tag.Get("json"), ",")[0]
+			if tag == "-" {
+				continue
+			}
+			if tag == "" {
+				tag = field.Name
+			}
+			fill(t, v.Field(i), tag)
+		}
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		fill(t, v.Index(0), name)
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return
+		}
+		value := reflect.New(v.Type().Elem()).Elem()
+		fill(t, value, name)
+		v.Set(reflect.MakeMap(v.Type()))
+		v.SetMapIndex(reflect.ValueOf("key").Convert(v.Type().Key()), value)
+	case reflect.String:
+		switch {
+		case secretLooking.MatchString(name):
+			v.SetString("SECRET-" + name)
+		case strings.Contains(strings.ToLower(name), "uri") || strings.Contains(strings.ToLower(name), "path"):
+			v.SetString("s3://user:SECRET-" + name + "@bucket/dump.rdb")
+		default:
+			v.SetString("value")
+		}
+	}
+}
+
+func TestRedactFields(t *testing.T) {
+	mockLogger := &mockedLogger{}
+	body := `{"name": "example", "internalNote": "do not log", "password": "pass"}`
+	request := &http.Request{
+		Method:        http.MethodPost,
+		URL:           &url.URL{Scheme: "https", Host: "example.org", Path: "/foo"},
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        map[string][]string{},
+		Body:          io.NopCloser(bytes.NewBufferString(body)),
+		ContentLength: int64(len(body)),
+		Host:          "example.org",
+	}
+	mockTripper := &mockedRoundTripper{}
+	mockTripper.On("RoundTrip", request).Return(&http.Response{StatusCode: http.StatusNoContent, Proto: "HTTP/1.1", ProtoMajor: 1, ProtoMinor: 1}, nil)
+
+	config := &Options{logger: mockLogger, transport: mockTripper, logRequests: true, apiKey: "key", secretKey: "secret"}
+	RedactFields("internal_note")(config)
+
+	_, err := config.roundTripper().RoundTrip(request)
+	require.NoError(t, err)
+	assert.Contains(t, mockLogger.log[0], `{
+  "name": "example",
+  "internalNote": "REDACTED",
+  "password": "REDACTED"
+}`)
+}
@@ -0,0 +1,88 @@
+package redis
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+type optionalState int
+
+const (
+	optionalUnset optionalState = iota
+	optionalNull
+	optionalSet
+)
+
+// Optional is a request field that can be left out of the request, explicitly sent as `null`, or sent with a value.
+// A plain pointer can't tell the first two apart, and a pointer to a slice was needed to send an empty list.
+//
+// The zero value is unset. Fields of this type must be tagged `omitzero` so that unset fields are left out:
+//
+//	Alerts redis.Optional[[]*Alert] `json:"alerts,omitzero"`
+type Optional[T any] struct {
+	value T
+	state optionalState
+}
+
+// Set returns an Optional which is sent with the given value. Setting an empty slice sends an empty list.
+func Set[T any](v T) Optional[T] {
+	return Optional[T]{value: v, state: optionalSet}
+}
+
+// Null returns an Optional which is sent as `null`, clearing the field.
+func Null[T any]() Optional[T] {
+	return Optional[T]{state: optionalNull}
+}
+
+// FromPtr returns an Optional which is sent with the value pointed to, or is left unset when the pointer is nil.
+func FromPtr[T any](p *T) Optional[T] {
+	if p == nil {
+		return Optional[T]{}
+	}
+	return Set(*p)
+}
+
+// IsZero reports whether the field is unset. This is what `omitzero` uses to leave the field out of the request.
+func (o Optional[T]) IsZero() bool {
+	return o.state == optionalUnset
+}
+
+// IsNull reports whether the field is explicitly sent as `null`.
+func (o Optional[T]) IsNull() bool {
+	return o.state == optionalNull
+}
+
+// Get returns the value and true when the field has been set to a value, or the zero value of T and false when it
+// is unset or null.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.state == optionalSet
+}
+
+// Ptr returns a pointer to the value, or nil when the field is unset or null.
+func (o Optional[T]) Ptr() *T {
+	if o.state != optionalSet {
+		return nil
+	}
+	v := o.value
+	return &v
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if o.state != optionalSet {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Null[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Set(v)
+	return nil
+}
@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type optionalRequest struct {
+	Alerts Optional[[]string] `json:"alerts,omitzero"`
+	Name   Optional[string]   `json:"name,omitzero"`
+}
+
+func TestOptional_MarshalJSON(t *testing.T) {
+	tc := []struct {
+		description string
+		request     optionalRequest
+		expected    string
+	}{
+		{
+			description: "unset fields are left out",
+			request:     optionalRequest{},
+			expected:    `{}`,
+		},
+		{
+			description: "null fields are sent as null",
+			request:     optionalRequest{Alerts: Null[[]string](), Name: Null[string]()},
+			expected:    `{"alerts":null,"name":null}`,
+		},
+		{
+			description: "empty slices are sent as empty lists",
+			request:     optionalRequest{Alerts: Set([]string{}), Name: Set("")},
+			expected:    `{"alerts":[],"name":""}`,
+		},
+		{
+			description: "values are sent",
+			request:     optionalRequest{Alerts: Set([]string{"a"}), Name: FromPtr(String("example"))},
+			expected:    `{"alerts":["a"],"name":"example"}`,
+		},
+	}
+	for _, test := range tc {
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := json.Marshal(test.request)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(actual))
+		})
+	}
+}
+
+func TestOptional_UnmarshalJSON(t *testing.T) {
+	var request optionalRequest
+	require.NoError(t, json.Unmarshal([]byte(`{"alerts":null,"name":"example"}`), &request))
+
+	assert.True(t, request.Alerts.IsNull())
+	assert.Nil(t, request.Alerts.Ptr())
+	name, ok := request.Name.Get()
+	assert.True(t, ok)
+	assert.Equal(t, "example", name)
+
+	request = optionalRequest{}
+	require.NoError(t, json.Unmarshal([]byte(`{}`), &request))
+	assert.True(t, request.Alerts.IsZero())
+	assert.False(t, request.Alerts.IsNull())
+	assert.Nil(t, FromPtr[string](nil).Ptr())
+}
@@ -74,3 +74,17 @@ func StringSliceValue(ss ...*string) []string {
 	}
 	return ret
 }
+
+// Ptr returns a pointer to any value, e.g. to one of the typed constants of the service packages.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Value dereferences a pointer of any type, returning the zero value when it's nil.
+func Value[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
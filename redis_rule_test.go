@@ -595,28 +595,28 @@ func TestListRedisRules(t *testing.T) {
 			Name:      redis.String("ACL-rule-example"),
 			ACL:       redis.String("+@all"),
 			IsDefault: redis.Bool(false),
-			Status:    redis.String("active"),
+			Status:    redis.Ptr(redis_rules.StatusActive),
 		},
 		{
 			ID:        redis.Int(76),
 			Name:      redis.String("Full-Access"),
 			ACL:       redis.String("+@all  ~*"),
 			IsDefault: redis.Bool(true),
-			Status:    redis.String("active"),
+			Status:    redis.Ptr(redis_rules.StatusActive),
 		},
 		{
 			ID:        redis.Int(77),
 			Name:      redis.String("Read-Write"),
 			ACL:       redis.String("+@all -@dangerous ~*"),
 			IsDefault: redis.Bool(true),
-			Status:    redis.String("active"),
+			Status:    redis.Ptr(redis_rules.StatusActive),
 		},
 		{
 			ID:        redis.Int(78),
 			Name:      redis.String("Read-Only"),
 			ACL:       redis.String("+@read ~*"),
 			IsDefault: redis.Bool(true),
-			Status:    redis.String("active"),
+			Status:    redis.Ptr(redis_rules.StatusActive),
 		},
 	}, actual)
 
@@ -739,7 +739,7 @@ func TestGetRedisRule(t *testing.T) {
 		Name:      redis.String("ACL-rule-example"),
 		ACL:       redis.String("+@all"),
 		IsDefault: redis.Bool(false),
-		Status:    redis.String("active"),
+		Status:    redis.Ptr(redis_rules.StatusActive),
 	}, actual)
 
 }
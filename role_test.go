@@ -679,7 +679,7 @@ func TestListRoles(t *testing.T) {
 				},
 			},
 			Users:  []*roles.GetUserInRoleResponse{},
-			Status: redis.String(roles.StatusActive),
+			Status: redis.Ptr(roles.StatusActive),
 		},
 		{
 			ID:   redis.Int(999),
@@ -699,7 +699,7 @@ func TestListRoles(t *testing.T) {
 				},
 			},
 			Users:  []*roles.GetUserInRoleResponse{},
-			Status: redis.String("active"),
+			Status: redis.Ptr(roles.StatusActive),
 		},
 		{
 			ID:         redis.Int(27),
@@ -711,7 +711,7 @@ func TestListRoles(t *testing.T) {
 					Name: redis.String("test-user"),
 				},
 			},
-			Status: redis.String(roles.StatusActive),
+			Status: redis.Ptr(roles.StatusActive),
 		},
 	}, actual)
 
@@ -905,7 +905,7 @@ func TestGetRole(t *testing.T) {
 				Name: redis.String("test-user"),
 			},
 		},
-		Status: redis.String(roles.StatusActive),
+		Status: redis.Ptr(roles.StatusActive),
 	}, actual)
 
 }
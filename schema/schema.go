@@ -0,0 +1,203 @@
+// Package schema publishes JSON Schemas of the SDK's request and response models, generated from the Go types, their
+// JSON tags and the helpers listing the values of each enum.
+//
+// The schemas are kept in the json directory, one file per model, and are regenerated with `go generate ./schema`.
+// Request schemas are strict: they reject unknown properties and values outside of an enum, just as the API would.
+// Response schemas only describe the properties the library knows of, as the API may add properties and values.
+package schema
+
+//go:generate go test . -run TestFiles_areUpToDate -update
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal/jsonschema"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/redis_rules"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/roles"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/users"
+	"github.com/RedisLabs/rediscloud-go-api/service/account"
+	"github.com/RedisLabs/rediscloud-go-api/service/cloud_accounts"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	fixedDatabases "github.com/RedisLabs/rediscloud-go-api/service/fixed/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/fixed/plans"
+	fixedSubscriptions "github.com/RedisLabs/rediscloud-go-api/service/fixed/subscriptions"
+	"github.com/RedisLabs/rediscloud-go-api/service/latest_backups"
+	"github.com/RedisLabs/rediscloud-go-api/service/latest_imports"
+	"github.com/RedisLabs/rediscloud-go-api/service/maintenance"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+	"github.com/RedisLabs/rediscloud-go-api/service/privatelink"
+	"github.com/RedisLabs/rediscloud-go-api/service/psc"
+	"github.com/RedisLabs/rediscloud-go-api/service/regions"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/RedisLabs/rediscloud-go-api/service/tags"
+	"github.com/RedisLabs/rediscloud-go-api/service/transit_gateway/attachments"
+)
+
+// BaseURL is where the schemas are published, and is used to build their `$id`.
+const BaseURL = "https://raw.githubusercontent.com/RedisLabs/rediscloud-go-api/main/schema/json/"
+
+// Dir is the directory, relative to this package, the schemas are written to.
+const Dir = "json"
+
+// requests are the models sent to the API.
+var requests = map[string]interface{}{
+	"databases.CreateDatabase":                                databases.CreateDatabase{},
+	"databases.UpdateDatabase":                                databases.UpdateDatabase{},
+	"databases.CreateActiveActiveDatabase":                    databases.CreateActiveActiveDatabase{},
+	"databases.UpdateActiveActiveDatabase":                    databases.UpdateActiveActiveDatabase{},
+	"databases.Import":                                        databases.Import{},
+	"databases.UpgradeRedisVersion":                           databases.UpgradeRedisVersion{},
+	"fixed.databases.CreateFixedDatabase":                     fixedDatabases.CreateFixedDatabase{},
+	"fixed.databases.UpdateFixedDatabase":                     fixedDatabases.UpdateFixedDatabase{},
+	"fixed.databases.Import":                                  fixedDatabases.Import{},
+	"subscriptions.CreateSubscription":                        subscriptions.CreateSubscription{},
+	"subscriptions.UpdateSubscription":                        subscriptions.UpdateSubscription{},
+	"subscriptions.UpdateSubscriptionCMKs":                    subscriptions.UpdateSubscriptionCMKs{},
+	"subscriptions.UpdateCIDRAllowlist":                       subscriptions.UpdateCIDRAllowlist{},
+	"subscriptions.CreateVPCPeering":                          subscriptions.CreateVPCPeering{},
+	"subscriptions.CreateActiveActiveVPCPeering":              subscriptions.CreateActiveActiveVPCPeering{},
+	"fixed.subscriptions.FixedSubscriptionRequest":            fixedSubscriptions.FixedSubscriptionRequest{},
+	"access_control_lists.redis_rules.CreateRedisRuleRequest": redis_rules.CreateRedisRuleRequest{},
+	"access_control_lists.roles.CreateRoleRequest":            roles.CreateRoleRequest{},
+	"access_control_lists.users.CreateUserRequest":            users.CreateUserRequest{},
+	"access_control_lists.users.UpdateUserRequest":            users.UpdateUserRequest{},
+	"cloud_accounts.CreateCloudAccount":                       cloud_accounts.CreateCloudAccount{},
+	"cloud_accounts.UpdateCloudAccount":                       cloud_accounts.UpdateCloudAccount{},
+	"maintenance.Maintenance":                                 maintenance.Maintenance{},
+	"privatelink.CreatePrivateLink":                           privatelink.CreatePrivateLink{},
+	"privatelink.CreatePrivateLinkActiveActive":               privatelink.CreatePrivateLinkActiveActive{},
+	"privatelink.CreatePrivateLinkPrincipal":                  privatelink.CreatePrivateLinkPrincipal{},
+	"psc.CreatePrivateServiceConnectEndpoint":                 psc.CreatePrivateServiceConnectEndpoint{},
+	"psc.UpdatePrivateServiceConnectEndpoint":                 psc.UpdatePrivateServiceConnectEndpoint{},
+	"regions.CreateRegion":                                    regions.CreateRegion{},
+	"regions.DeleteRegions":                                   regions.DeleteRegions{},
+	"tags.AllTags":                                            tags.AllTags{},
+}
+
+// responses are the models returned by the API.
+var responses = map[string]interface{}{
+	"databases.Database":                                    databases.Database{},
+	"databases.ActiveActiveDatabase":                        databases.ActiveActiveDatabase{},
+	"fixed.databases.FixedDatabase":                         fixedDatabases.FixedDatabase{},
+	"subscriptions.Subscription":                            subscriptions.Subscription{},
+	"subscriptions.VPCPeering":                              subscriptions.VPCPeering{},
+	"subscriptions.ActiveActiveVPCPeering":                  subscriptions.ActiveActiveVPCPeering{},
+	"subscriptions.CIDRAllowlist":                           subscriptions.CIDRAllowlist{},
+	"subscriptions.RedisVersions":                           subscriptions.RedisVersions{},
+	"subscriptions.ListAASubscriptionRegionsResponse":       subscriptions.ListAASubscriptionRegionsResponse{},
+	"fixed.subscriptions.FixedSubscriptionResponse":         fixedSubscriptions.FixedSubscriptionResponse{},
+	"fixed.plans.GetPlanResponse":                           plans.GetPlanResponse{},
+	"access_control_lists.redis_rules.GetRedisRuleResponse": redis_rules.GetRedisRuleResponse{},
+	"access_control_lists.roles.GetRoleResponse":            roles.GetRoleResponse{},
+	"access_control_lists.users.GetUserResponse":            users.GetUserResponse{},
+	"account.PaymentMethod":                                 account.PaymentMethod{},
+	"cloud_accounts.CloudAccount":                           cloud_accounts.CloudAccount{},
+	"latest_backups.LatestBackupStatus":                     latest_backups.LatestBackupStatus{},
+	"latest_imports.LatestImportStatus":                     latest_imports.LatestImportStatus{},
+	"pricing.ListPricingResponse":                           pricing.ListPricingResponse{},
+	"privatelink.PrivateLink":                               privatelink.PrivateLink{},
+	"privatelink.PrivateLinkActiveActive":                   privatelink.PrivateLinkActiveActive{},
+	"psc.PrivateServiceConnectService":                      psc.PrivateServiceConnectService{},
+	"psc.PrivateServiceConnectEndpoints":                    psc.PrivateServiceConnectEndpoints{},
+	"regions.Regions":                                       regions.Regions{},
+	"transit_gateway.attachments.TransitGatewayAttachment":  attachments.TransitGatewayAttachment{},
+}
+
+// Models returns every model the schemas describe, requests and responses alike, keyed by the name of their schema.
+func Models() map[string]interface{} {
+	models := make(map[string]interface{}, len(requests)+len(responses))
+	for name, model := range requests {
+		models[name] = model
+	}
+	for name, model := range responses {
+		models[name] = model
+	}
+	return models
+}
+
+// NewGenerator returns a generator which knows the values of every enum of the SDK.
+func NewGenerator(strict bool) *jsonschema.Generator {
+	g := jsonschema.NewGenerator(strict)
+
+	jsonschema.Enum(g, databases.StatusValues())
+	jsonschema.Enum(g, typed[databases.DataPersistence](databases.DataPersistenceValues()))
+	jsonschema.Enum(g, typed[databases.EvictionPolicy](databases.DataEvictionPolicyValues()))
+	jsonschema.Enum(g, subscriptions.StatusValues())
+	jsonschema.Enum(g, subscriptions.VPCPeeringStatusValues())
+	jsonschema.Enum(g, typed[subscriptions.DeploymentType](subscriptions.DeploymentTypeValues()))
+	jsonschema.Enum(g, fixedSubscriptions.StatusValues())
+	jsonschema.Enum(g, cloud_accounts.StatusValues())
+	jsonschema.Enum(g, users.StatusValues())
+	jsonschema.Enum(g, roles.StatusValues())
+	jsonschema.Enum(g, redis_rules.StatusValues())
+	jsonschema.Enum(g, privatelink.StatusValues())
+	jsonschema.Enum(g, privatelink.PrincipalStatusValues())
+	jsonschema.Enum(g, psc.ServiceStatusValues())
+	jsonschema.Enum(g, psc.EndpointStatusValues())
+
+	g.FieldEnum(databases.CreateDatabase{}, "protocol", databases.ProtocolValues())
+	g.FieldEnum(databases.CreateDatabase{}, "respVersion", databases.RespVersionValues())
+	g.FieldEnum(databases.UpdateDatabase{}, "respVersion", databases.RespVersionValues())
+	g.FieldEnum(databases.CreateActiveActiveDatabase{}, "protocol", databases.ProtocolValues())
+	g.FieldEnum(databases.CreateActiveActiveDatabase{}, "respVersion", databases.RespVersionValues())
+	g.FieldEnum(databases.CreateThroughputMeasurement{}, "by", databases.ThroughputMeasurementByValues())
+	g.FieldEnum(databases.UpdateThroughputMeasurement{}, "by", databases.ThroughputMeasurementByValues())
+	g.FieldEnum(databases.Alert{}, "name", databases.AlertNameValues())
+	g.FieldEnum(databases.DatabaseBackupConfig{}, "interval", databases.BackupIntervals())
+	g.FieldEnum(databases.DatabaseBackupConfig{}, "storageType", databases.BackupStorageTypes())
+	g.FieldEnum(databases.Import{}, "sourceType", databases.SourceTypeValues())
+	g.FieldEnum(fixedDatabases.CreateFixedDatabase{}, "protocol", fixedDatabases.ProtocolValues())
+	g.FieldEnum(fixedDatabases.CreateFixedDatabase{}, "respVersion", databases.RespVersionValues())
+	g.FieldEnum(fixedDatabases.UpdateFixedDatabase{}, "respVersion", databases.RespVersionValues())
+	g.FieldEnum(fixedDatabases.Import{}, "sourceType", databases.SourceTypeValues())
+	g.FieldEnum(subscriptions.CreateSubscription{}, "paymentMethod", subscriptions.PaymentMethodValues())
+	g.FieldEnum(subscriptions.CreateSubscription{}, "memoryStorage", databases.MemoryStorageValues())
+	g.FieldEnum(subscriptions.CreateCloudProvider{}, "provider", cloud_accounts.ProviderValues())
+	g.FieldEnum(subscriptions.CreateDatabase{}, "protocol", databases.ProtocolValues())
+	g.FieldEnum(subscriptions.CreateThroughput{}, "by", databases.ThroughputMeasurementByValues())
+	g.FieldEnum(subscriptions.CreateVPCPeering{}, "provider", cloud_accounts.ProviderValues())
+	g.FieldEnum(subscriptions.CreateActiveActiveVPCPeering{}, "provider", cloud_accounts.ProviderValues())
+	g.FieldEnum(fixedSubscriptions.FixedSubscriptionRequest{}, "paymentMethod", subscriptions.PaymentMethodValues())
+	g.FieldEnum(cloud_accounts.CreateCloudAccount{}, "provider", cloud_accounts.ProviderValues())
+	g.FieldEnum(regions.CreateRegion{}, "respVersion", databases.RespVersionValues())
+
+	return g
+}
+
+// Files returns the content of every schema, keyed by its file name within Dir.
+func Files() (map[string][]byte, error) {
+	files := map[string][]byte{}
+	for _, set := range []struct {
+		models map[string]interface{}
+		strict bool
+	}{{requests, true}, {responses, false}} {
+		g := NewGenerator(set.strict)
+		for name, model := range set.models {
+			file := name + ".json"
+			data, err := Marshal(g.Generate(model, BaseURL+file, name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate the schema of %s: %w", name, err)
+			}
+			files[file] = data
+		}
+	}
+	return files, nil
+}
+
+// Marshal formats a schema the way it's published.
+func Marshal(schema *jsonschema.Schema) ([]byte, error) {
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func typed[T ~string](values []string) []T {
+	ret := make([]T, 0, len(values))
+	for _, value := range values {
+		ret = append(ret, T(value))
+	}
+	return ret
+}
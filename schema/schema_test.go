@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite the schemas in the json directory")
+
+func TestFiles_areUpToDate(t *testing.T) {
+	files, err := Files()
+	require.NoError(t, err)
+
+	if *update {
+		require.NoError(t, os.RemoveAll(Dir))
+		require.NoError(t, os.MkdirAll(Dir, 0o755))
+		for name, data := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(Dir, name), data, 0o644))
+		}
+	}
+
+	entries, err := os.ReadDir(Dir)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		_, ok := files[entry.Name()]
+		assert.True(t, ok, "%s no longer matches a model, run `go generate ./schema`", entry.Name())
+	}
+
+	for name, expected := range files {
+		published, err := os.ReadFile(filepath.Join(Dir, name))
+		if !assert.NoError(t, err, "%s is missing, run `go generate ./schema`", name) {
+			continue
+		}
+		assert.Equal(t, string(published), string(expected), "%s is out of date, run `go generate ./schema`", name)
+	}
+}
+
+func TestFiles_requestsAreStrict(t *testing.T) {
+	files, err := Files()
+	require.NoError(t, err)
+
+	request := string(files["databases.CreateDatabase.json"])
+	assert.Contains(t, request, `"additionalProperties": false`)
+	assert.Contains(t, request, `"resp2"`)
+	assert.Contains(t, request, `"memcached"`)
+
+	response := string(files["databases.Database.json"])
+	assert.NotContains(t, response, `"additionalProperties": false`)
+	assert.NotContains(t, response, `"enum"`)
+}
@@ -0,0 +1,86 @@
+package rediscloud_api
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSecretTags_everyExportedType reads the fields of every exported type of the services, checking those whose
+// names look like secrets are tagged `secret:"true"`, and that the redactor of logged requests knows of every one.
+func TestSecretTags_everyExportedType(t *testing.T) {
+	redacted := map[string]bool{}
+	for _, field := range internal.SecretFields {
+		redacted[field] = true
+	}
+
+	tagged := 0
+	err := filepath.WalkDir("service", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			spec, ok := node.(*ast.TypeSpec)
+			if !ok || !spec.Name.IsExported() {
+				return true
+			}
+			structType, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				return true
+			}
+			for _, field := range structType.Fields.List {
+				if field.Tag == nil || len(field.Names) == 0 || !field.Names[0].IsExported() {
+					continue
+				}
+				value, err := strconv.Unquote(field.Tag.Value)
+				require.NoError(t, err)
+				tag := reflect.StructTag(value)
+				name := strings.Split(tag.Get("json"), ",")[0]
+				where := fmt.Sprintf("%s: %s.%s", path, spec.Name.Name, field.Names[0].Name)
+
+				if secretLooking.MatchString(name) {
+					assert.Equal(t, "true", tag.Get("secret"), "%s should be tagged secret:\"true\"", where)
+				}
+				if tag.Get("secret") == "true" {
+					tagged++
+					assert.True(t, redacted[name], "%s is secret, so %q should be in internal.SecretFields", where, name)
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Greater(t, tagged, 0)
+}
+
+// TestSecretTags_String fills every property of every model and checks the secret ones can't be found once the model
+// is printed, with its String method if it has one.
+func TestSecretTags_String(t *testing.T) {
+	for name, model := range schema.Models() {
+		t.Run(name, func(t *testing.T) {
+			v := reflect.New(reflect.TypeOf(model)).Elem()
+			fill(t, v, "")
+
+			assert.NotContains(t, fmt.Sprint(v.Interface()), "SECRET-")
+			assert.NotContains(t, fmt.Sprint(v.Addr().Interface()), "SECRET-")
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package redis_rules
+
+import "slices"
+
+// Status is the value of the `Status` field in `RedisRule`. Values introduced by the API after this version of the
+// library are unmarshalled as-is rather than rejected, and report false from IsValid.
+type Status string
+
+const (
+	// StatusActive is the active value of the `Status` field in `RedisRule`
+	StatusActive Status = "active"
+	// StatusPending is the pending value of the `Status` field in `RedisRule`
+	StatusPending Status = "pending"
+	// StatusError is the error value of the `Status` field in `RedisRule`
+	StatusError Status = "error"
+	// StatusDeleting is the deleting value of the `Status` field in `RedisRule`
+	StatusDeleting Status = "deleting"
+)
+
+func StatusValues() []Status {
+	return []Status{
+		StatusActive,
+		StatusPending,
+		StatusError,
+		StatusDeleting,
+	}
+}
+
+// IsValid reports whether the status is one known to this version of the library.
+func (s Status) IsValid() bool {
+	return slices.Contains(StatusValues(), s)
+}
+
+// IsTerminal reports whether the Redis rule has settled, i.e. it won't change status again without another request.
+func (s Status) IsTerminal() bool {
+	return s == StatusActive || s == StatusError
+}
+
+// IsError reports whether the Redis rule has failed.
+func (s Status) IsError() bool {
+	return s == StatusError
+}
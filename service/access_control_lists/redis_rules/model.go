@@ -18,7 +18,7 @@ type GetRedisRuleResponse struct {
 	Name      *string `json:"name,omitempty"`
 	ACL       *string `json:"acl,omitempty"`
 	IsDefault *bool   `json:"isDefault,omitempty"`
-	Status    *string `json:"status,omitempty"`
+	Status    *Status `json:"status,omitempty"`
 }
 
 func (o GetRedisRuleResponse) String() string {
@@ -35,14 +35,3 @@ type CreateRedisRuleRequest struct {
 func (o CreateRedisRuleRequest) String() string {
 	return internal.ToString(o)
 }
-
-const (
-	// StatusActive is the active value of the `Status` field in `RedisRule`
-	StatusActive = "active"
-	// StatusPending is the pending value of the `Status` field in `RedisRule`
-	StatusPending = "pending"
-	// StatusError is the error value of the `Status` field in `RedisRule`
-	StatusError = "error"
-	// StatusDeleting is the deleting value of the `Status` field in `RedisRule`
-	StatusDeleting = "deleting"
-)
@@ -70,7 +70,7 @@ func (a *API) Create(ctx context.Context, redisRule CreateRedisRuleRequest) (int
 		return 0, err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish creating the redisRule", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish creating the redisRule", task), internal.TaskID(task.ID))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -88,7 +88,7 @@ func (a *API) Update(ctx context.Context, id int, redisRule CreateRedisRuleReque
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for task %s to finish updating the redisRule", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish updating the redisRule", task), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
@@ -106,7 +106,7 @@ func (a *API) Delete(ctx context.Context, id int) error {
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for redisRule %d to finish being deleted", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for redisRule %d to finish being deleted", id), internal.ResourceID("redis_rule", id))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
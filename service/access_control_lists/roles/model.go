@@ -14,7 +14,7 @@ type GetRoleResponse struct {
 	Name       *string                  `json:"name,omitempty"`
 	RedisRules []*GetRuleInRoleResponse `json:"redisRules,omitempty"`
 	Users      []*GetUserInRoleResponse `json:"users,omitempty"`
-	Status     *string                  `json:"status,omitempty"`
+	Status     *Status                  `json:"status,omitempty"`
 }
 
 func (o GetRoleResponse) String() string {
@@ -76,14 +76,3 @@ type CreateDatabaseInRuleInRoleRequest struct {
 func (o CreateDatabaseInRuleInRoleRequest) String() string {
 	return internal.ToString(o)
 }
-
-const (
-	// StatusActive is the active value of the `Status` field in `Role`
-	StatusActive = "active"
-	// StatusPending is the pending value of the `Status` field in `Role`
-	StatusPending = "pending"
-	// StatusError is the error value of the `Status` field in `Role`
-	StatusError = "error"
-	// StatusDeleting is the deleting value of the `Status` field in `Role`
-	StatusDeleting = "deleting"
-)
@@ -70,7 +70,7 @@ func (a *API) Create(ctx context.Context, role CreateRoleRequest) (int, error) {
 		return 0, err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish creating the role", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish creating the role", task), internal.TaskID(task.ID))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -88,7 +88,7 @@ func (a *API) Update(ctx context.Context, id int, role CreateRoleRequest) error
 		return err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish updating the role", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish updating the role", task), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
@@ -106,7 +106,7 @@ func (a *API) Delete(ctx context.Context, id int) error {
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for role %d to finish being deleted", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for role %d to finish being deleted", id), internal.ResourceID("role", id))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
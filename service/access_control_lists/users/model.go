@@ -17,7 +17,7 @@ type GetUserResponse struct {
 	ID     *int    `json:"id,omitempty"`
 	Name   *string `json:"name,omitempty"`
 	Role   *string `json:"role,omitempty"`
-	Status *string `json:"status,omitempty"`
+	Status *Status `json:"status,omitempty"`
 }
 
 func (o GetUserResponse) String() string {
@@ -29,7 +29,7 @@ func (o GetUserResponse) String() string {
 type CreateUserRequest struct {
 	Name     *string `json:"name,omitempty"`
 	Role     *string `json:"role,omitempty"`
-	Password *string `json:"password,omitempty"`
+	Password *string `json:"password,omitempty" secret:"true"`
 }
 
 func (o CreateUserRequest) String() string {
@@ -40,20 +40,9 @@ func (o CreateUserRequest) String() string {
 
 type UpdateUserRequest struct {
 	Role     *string `json:"role,omitempty"`
-	Password *string `json:"password,omitempty"`
+	Password *string `json:"password,omitempty" secret:"true"`
 }
 
 func (o UpdateUserRequest) String() string {
 	return internal.ToString(o)
 }
-
-const (
-	// StatusActive is the active value of the `Status` field in `User`
-	StatusActive = "active"
-	// StatusPending is the pending value of the `Status` field in `User`
-	StatusPending = "pending"
-	// StatusError is the error value of the `Status` field in `User`
-	StatusError = "error"
-	// StatusDeleting is the deleting value of the `Status` field in `User`
-	StatusDeleting = "deleting"
-)
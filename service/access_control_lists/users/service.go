@@ -65,7 +65,7 @@ func (a *API) Create(ctx context.Context, user CreateUserRequest) (int, error) {
 		return 0, err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish creating the user", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish creating the user", task), internal.TaskID(task.ID))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -83,7 +83,7 @@ func (a *API) Update(ctx context.Context, id int, user UpdateUserRequest) error
 		return err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish updating the user", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish updating the user", task), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
@@ -101,7 +101,7 @@ func (a *API) Delete(ctx context.Context, id int) error {
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for user %d to finish being deleted", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for user %d to finish being deleted", id), internal.ResourceID("user", id))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
@@ -0,0 +1,12 @@
+package users
+
+import "github.com/RedisLabs/rediscloud-go-api/internal"
+
+// Validate checks the request for problems that the API would otherwise reject, without contacting the API.
+func (o CreateUserRequest) Validate() error {
+	v := internal.NewValidation("create user request")
+	v.Required("name", o.Name != nil)
+	v.Required("role", o.Role != nil)
+	v.Required("password", o.Password != nil)
+	return v.Err()
+}
@@ -0,0 +1,54 @@
+package cloud_accounts
+
+import "slices"
+
+// Status is the value of the `Status` field in `CloudAccount`. Values introduced by the API after this version of the
+// library are unmarshalled as-is rather than rejected, and report false from IsValid.
+type Status string
+
+const (
+	// StatusDraft is the draft value of the `Status` field in `CloudAccount`
+	StatusDraft Status = "draft"
+	// StatusPending is the pending value of the `Status` field in `CloudAccount`
+	StatusPending Status = "pending"
+	// StatusActive is the active value of the `Status` field in `CloudAccount`
+	StatusActive Status = "active"
+	// StatusChangeDraft is the change draft value of the `Status` field in `CloudAccount`
+	StatusChangeDraft Status = "change-draft"
+	// StatusChangePending is the change pending value of the `Status` field in `CloudAccount`
+	StatusChangePending Status = "change-pending"
+	// StatusDeleteDraft is the delete draft value of the `Status` field in `CloudAccount`
+	StatusDeleteDraft Status = "delete-draft"
+	// StatusDeleted is the deleted value of the `Status` field in `CloudAccount`
+	StatusDeleted Status = "deleted"
+	// StatusActiveError is the active error value of the `Status` field in `CloudAccount`
+	StatusActiveError Status = "active-error"
+)
+
+func StatusValues() []Status {
+	return []Status{
+		StatusDraft,
+		StatusPending,
+		StatusActive,
+		StatusChangeDraft,
+		StatusChangePending,
+		StatusDeleteDraft,
+		StatusDeleted,
+		StatusActiveError,
+	}
+}
+
+// IsValid reports whether the status is one known to this version of the library.
+func (s Status) IsValid() bool {
+	return slices.Contains(StatusValues(), s)
+}
+
+// IsTerminal reports whether the cloud account has settled, i.e. it won't change status again without another request.
+func (s Status) IsTerminal() bool {
+	return s == StatusActive || s == StatusDeleted || s == StatusActiveError
+}
+
+// IsError reports whether the cloud account has failed.
+func (s Status) IsError() bool {
+	return s == StatusActiveError
+}
@@ -7,10 +7,10 @@ import (
 )
 
 type CreateCloudAccount struct {
-	AccessKeyID     *string `json:"accessKeyId,omitempty"`
-	AccessSecretKey *string `json:"accessSecretKey,omitempty"`
+	AccessKeyID     *string `json:"accessKeyId,omitempty" secret:"true"`
+	AccessSecretKey *string `json:"accessSecretKey,omitempty" secret:"true"`
 	ConsoleUsername *string `json:"consoleUsername,omitempty"`
-	ConsolePassword *string `json:"consolePassword,omitempty"`
+	ConsolePassword *string `json:"consolePassword,omitempty" secret:"true"`
 	Name            *string `json:"name,omitempty"`
 	Provider        *string `json:"provider,omitempty"`
 	SignInLoginURL  *string `json:"signInLoginUrl,omitempty"`
@@ -21,10 +21,10 @@ func (o CreateCloudAccount) String() string {
 }
 
 type UpdateCloudAccount struct {
-	AccessKeyID     *string `json:"accessKeyId,omitempty"`
-	AccessSecretKey *string `json:"accessSecretKey,omitempty"`
+	AccessKeyID     *string `json:"accessKeyId,omitempty" secret:"true"`
+	AccessSecretKey *string `json:"accessSecretKey,omitempty" secret:"true"`
 	ConsoleUsername *string `json:"consoleUsername,omitempty"`
-	ConsolePassword *string `json:"consolePassword,omitempty"`
+	ConsolePassword *string `json:"consolePassword,omitempty" secret:"true"`
 	Name            *string `json:"name,omitempty"`
 	SignInLoginURL  *string `json:"signInLoginUrl,omitempty"`
 }
@@ -49,33 +49,14 @@ type CloudAccount struct {
 	ID          *int    `json:"id"`
 	Name        *string `json:"name,omitempty"`
 	Provider    *string `json:"provider,omitempty"`
-	Status      *string `json:"status,omitempty"`
-	AccessKeyID *string `json:"accessKeyId,omitempty"`
+	Status      *Status `json:"status,omitempty"`
+	AccessKeyID *string `json:"accessKeyId,omitempty" secret:"true"`
 }
 
 func (o CloudAccount) String() string {
 	return internal.ToString(o)
 }
 
-const (
-	// StatusDraft is the draft value of the `Status` field in `CloudAccount`
-	StatusDraft = "draft"
-	// StatusPending is the pending value of the `Status` field in `CloudAccount`
-	StatusPending = "pending"
-	// StatusActive is the active value of the `Status` field in `CloudAccount`
-	StatusActive = "active"
-	// StatusChangeDraft is the change draft value of the `Status` field in `CloudAccount`
-	StatusChangeDraft = "change-draft"
-	// StatusChangePending is the change pending value of the `Status` field in `CloudAccount`
-	StatusChangePending = "change-pending"
-	// StatusDeleteDraft is the delete draft value of the `Status` field in `CloudAccount`
-	StatusDeleteDraft = "delete-draft"
-	// StatusDeleted is the deleted value of the `Status` field in `CloudAccount`
-	StatusDeleted = "deleted"
-	// StatusActiveError is the active error value of the `Status` field in `CloudAccount`
-	StatusActiveError = "active-error"
-)
-
 func ProviderValues() []string {
 	return []string{
 		"AWS",
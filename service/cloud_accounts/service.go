@@ -42,7 +42,7 @@ func (a *API) Create(ctx context.Context, account CreateCloudAccount) (int, erro
 		return 0, err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish creating the cloud account", response)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish creating the cloud account", response), internal.TaskID(response.ID))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *response.ID)
 	if err != nil {
@@ -78,7 +78,7 @@ func (a *API) Update(ctx context.Context, id int, account UpdateCloudAccount) er
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for cloud account %d to finish being updated", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for cloud account %d to finish being updated", id), internal.ResourceID("cloud_account", id))
 
 	err := a.taskWaiter.Wait(ctx, *response.ID)
 	if err != nil {
@@ -95,7 +95,7 @@ func (a *API) Delete(ctx context.Context, id int) error {
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for cloud account %d to finish being deleted", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for cloud account %d to finish being deleted", id), internal.ResourceID("cloud_account", id))
 
 	if err := a.taskWaiter.Wait(ctx, *response.ID); err != nil {
 		return fmt.Errorf("failed when deleting account %d: %w", id, err)
@@ -0,0 +1,16 @@
+package cloud_accounts
+
+import "github.com/RedisLabs/rediscloud-go-api/internal"
+
+// Validate checks the request for problems that the API would otherwise reject, without contacting the API.
+func (o CreateCloudAccount) Validate() error {
+	v := internal.NewValidation("create cloud account request")
+	v.Required("name", o.Name != nil)
+	internal.OneOf(v, "provider", o.Provider, ProviderValues())
+	v.Required("accessKeyId", o.AccessKeyID != nil)
+	v.Required("accessSecretKey", o.AccessSecretKey != nil)
+	v.Required("consoleUsername", o.ConsoleUsername != nil)
+	v.Required("consolePassword", o.ConsolePassword != nil)
+	v.Required("signInLoginUrl", o.SignInLoginURL != nil)
+	return v.Err()
+}
@@ -0,0 +1,174 @@
+package databases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+)
+
+// CloneOptions customises how Clone creates the copy of a database.
+type CloneOptions struct {
+	// Overrides is applied on top of the configuration read from a Pro source database - any field set here replaces
+	// the value copied from the source.
+	Overrides *CreateDatabase
+	// ActiveActiveOverrides is applied on top of the configuration read from an Active-Active source database.
+	ActiveActiveOverrides *CreateActiveActiveDatabase
+	// CopyData triggers a backup of the source database and then imports ImportFrom into the clone.
+	CopyData bool
+	// ImportFrom is where data is imported from when CopyData is set and must be given with it - the API doesn't
+	// report the file a backup writes, so this names the RDB file(s) in the backup destination of the source. The
+	// source type will default to the one of the first URI.
+	ImportFrom *Import
+}
+
+// Clone reads the configuration of an existing (Pro or Active-Active) database and creates an equivalent database in
+// the destination subscription, returning the identifier of the new database. Data is only copied across when
+// requested by the options.
+//
+// Values the API never returns (e.g. passwords) must be supplied through the overrides, otherwise a MissingFields
+// error is returned before anything is created. When the import into the clone fails, the clone is deleted again.
+// Every task is waited for even when the call options are set not to, as each step depends on the previous one.
+func (a *API) Clone(ctx context.Context, srcSubscription int, srcDatabase int, dstSubscription int, options CloneOptions) (int, error) {
+	ctx = internal.ClearNoWait(ctx)
+
+	source, err := a.Get(ctx, srcSubscription, srcDatabase)
+	if err != nil {
+		return 0, err
+	}
+
+	var create func() (int, error)
+	var backup *Backup
+	if redis.BoolValue(source.ActiveActiveRedis) {
+		create, backup, err = a.prepareActiveActiveClone(ctx, srcSubscription, srcDatabase, dstSubscription, options)
+	} else {
+		create, backup, err = a.prepareProClone(ctx, source, dstSubscription, options)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var importFrom *Import
+	if options.CopyData {
+		if importFrom, err = importSource(backup, options.ImportFrom); err != nil {
+			return 0, err
+		}
+		// Back up first, so nothing is left behind in the destination subscription if the backup fails
+		if err := a.Backup(ctx, srcSubscription, srcDatabase); err != nil {
+			return 0, err
+		}
+	}
+
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Cloning database %d for subscription %d into subscription %d", srcDatabase, srcSubscription, dstSubscription), internal.DatabaseID(srcDatabase), internal.SubscriptionID(srcSubscription), slog.Int("target_subscription_id", dstSubscription))
+
+	id, err := create()
+	if err != nil {
+		return 0, err
+	}
+
+	if importFrom != nil {
+		if err := a.Import(ctx, dstSubscription, id, *importFrom); err != nil {
+			// Don't leave an empty clone behind
+			if deleteErr := a.Delete(ctx, dstSubscription, id); deleteErr != nil {
+				return id, errors.Join(err, fmt.Errorf("failed to delete clone %d: %w", id, deleteErr))
+			}
+			return 0, err
+		}
+	}
+
+	return id, nil
+}
+
+func (a *API) prepareProClone(ctx context.Context, source *Database, dstSubscription int, options CloneOptions) (func() (int, error), *Backup, error) {
+	request, missing := source.ToCreate()
+	internal.Overlay(&request, options.Overrides)
+
+	if err := checkMissing(request, missing); err != nil {
+		return nil, nil, err
+	}
+
+	return func() (int, error) {
+		return a.Create(ctx, dstSubscription, request)
+	}, source.Backup, nil
+}
+
+func (a *API) prepareActiveActiveClone(ctx context.Context, srcSubscription int, srcDatabase int, dstSubscription int, options CloneOptions) (func() (int, error), *Backup, error) {
+	source, err := a.GetActiveActive(ctx, srcSubscription, srcDatabase)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, missing := source.ToCreate()
+	internal.Overlay(&request, options.ActiveActiveOverrides)
+
+	if err := checkMissing(request, missing); err != nil {
+		return nil, nil, err
+	}
+
+	// Every region backs up to its own destination, any of them can be used to seed the clone
+	var backup *Backup
+	for _, crdb := range source.CrdbDatabases {
+		if crdb != nil && crdb.Backup != nil && redis.BoolValue(crdb.Backup.Enabled) {
+			backup = crdb.Backup
+			break
+		}
+	}
+
+	return func() (int, error) {
+		return a.ActiveActiveCreate(ctx, dstSubscription, request)
+	}, backup, nil
+}
+
+func checkMissing(request interface{}, missing []string) error {
+	var stillMissing []string
+	for _, field := range missing {
+		if internal.HasJSONField(request, field) {
+			continue
+		}
+		// Either form of client certificate is acceptable
+		if field == "clientTlsCertificates" && internal.HasJSONField(request, "clientSslCertificate") {
+			continue
+		}
+		stillMissing = append(stillMissing, field)
+	}
+	if len(stillMissing) > 0 {
+		return &MissingFields{Fields: stillMissing}
+	}
+	return nil
+}
+
+// importSource checks that data can be copied from the source database to the clone, and returns what to import.
+func importSource(backup *Backup, importFrom *Import) (*Import, error) {
+	if backup == nil || !redis.BoolValue(backup.Enabled) || backup.Destination == nil {
+		return nil, errors.New("source database has no remote backup configured to copy data from")
+	}
+	if importFrom == nil || len(importFrom.ImportFromURI) == 0 {
+		return nil, fmt.Errorf("ImportFrom must name the RDB file(s) to import from the backup destination %s", *backup.Destination)
+	}
+	for _, uri := range importFrom.ImportFromURI {
+		if redis.StringValue(uri) == "" {
+			return nil, errors.New("ImportFrom can't contain an empty URI")
+		}
+	}
+
+	source := *importFrom
+	if source.SourceType == nil {
+		if source.SourceType = backupStorageType(*source.ImportFromURI[0]); source.SourceType == nil {
+			return nil, fmt.Errorf("unable to determine the source type of %s", *source.ImportFromURI[0])
+		}
+	}
+	return &source, nil
+}
+
+// MissingFields is returned when a request can't be sent because values the API never returns weren't supplied.
+type MissingFields struct {
+	Fields []string
+}
+
+func (f *MissingFields) Error() string {
+	return "the following fields must be supplied: " + strings.Join(f.Fields, ", ")
+}
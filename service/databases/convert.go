@@ -0,0 +1,298 @@
+package databases
+
+import (
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+)
+
+// ToCreate converts the database into a CreateDatabase request with the same configuration, e.g. to clone it.
+//
+// Some values are never returned by the API, so the second return value lists the JSON names of the fields the
+// caller must supply themselves before the request can recreate the database faithfully.
+func (o Database) ToCreate() (CreateDatabase, []string) {
+	var missing []string
+	security := o.security()
+
+	create := CreateDatabase{
+		Name:                    o.Name,
+		Protocol:                o.Protocol,
+		MemoryLimitInGB:         o.MemoryLimitInGB,
+		DatasetSizeInGB:         o.DatasetSizeInGB,
+		SupportOSSClusterAPI:    o.SupportOSSClusterAPI,
+		RespVersion:             o.RespVersion,
+		DataPersistence:         o.DataPersistence,
+		DataEvictionPolicy:      o.DataEvictionPolicy,
+		Replication:             o.Replication,
+		SourceIP:                copyStrings(security.SourceIPs),
+		Password:                security.Password,
+		Alerts:                  copyAlerts(o.Alerts),
+		Modules:                 copyModules(o.Modules),
+		EnableTls:               security.EnableTls,
+		QueryPerformanceFactor:  o.QueryPerformanceFactor,
+		RedisVersion:            o.RedisVersion,
+		AutoMinorVersionUpgrade: o.AutoMinorVersionUpgrade,
+		RamPercentage:           o.RamPercentage,
+	}
+
+	if o.ThroughputMeasurement != nil {
+		create.ThroughputMeasurement = &CreateThroughputMeasurement{
+			By:    o.ThroughputMeasurement.By,
+			Value: o.ThroughputMeasurement.Value,
+		}
+	}
+
+	if o.ReplicaOf != nil {
+		create.ReplicaOf = copyStrings(o.ReplicaOf.Endpoints)
+	}
+
+	create.RemoteBackup, missing = toBackupConfig("remoteBackup", o.Backup, missing)
+
+	if security.Password == nil && redis.StringValue(o.Protocol) != "memcached" {
+		missing = append(missing, "password")
+	}
+	if redis.BoolValue(security.SSLClientAuthentication) || redis.BoolValue(security.TLSClientAuthentication) {
+		missing = append(missing, "clientTlsCertificates")
+	}
+
+	return create, missing
+}
+
+// ToUpdate converts the database into an UpdateDatabase request that re-applies its current configuration.
+//
+// Some values are never returned by the API, so the second return value lists the JSON names of the fields the
+// caller must supply themselves before the request can re-apply the configuration faithfully.
+func (o Database) ToUpdate() (UpdateDatabase, []string) {
+	var missing []string
+	security := o.security()
+
+	update := UpdateDatabase{
+		Name:                    o.Name,
+		MemoryLimitInGB:         o.MemoryLimitInGB,
+		DatasetSizeInGB:         o.DatasetSizeInGB,
+		SupportOSSClusterAPI:    o.SupportOSSClusterAPI,
+		RespVersion:             o.RespVersion,
+		DataEvictionPolicy:      o.DataEvictionPolicy,
+		Replication:             o.Replication,
+		DataPersistence:         o.DataPersistence,
+		SourceIP:                copyStrings(security.SourceIPs),
+		Password:                security.Password,
+		EnableTls:               security.EnableTls,
+		EnableDefaultUser:       security.EnableDefaultUser,
+		QueryPerformanceFactor:  o.QueryPerformanceFactor,
+		AutoMinorVersionUpgrade: o.AutoMinorVersionUpgrade,
+		RamPercentage:           o.RamPercentage,
+	}
+
+	update.Alerts = redis.Set(copyAlerts(o.Alerts))
+
+	if o.ThroughputMeasurement != nil {
+		update.ThroughputMeasurement = &UpdateThroughputMeasurement{
+			By:    o.ThroughputMeasurement.By,
+			Value: o.ThroughputMeasurement.Value,
+		}
+	}
+
+	if o.ReplicaOf != nil {
+		update.ReplicaOf = redis.Set(copyStrings(o.ReplicaOf.Endpoints))
+	}
+
+	if o.Clustering != nil {
+		for _, rule := range o.Clustering.RegexRules {
+			if rule != nil {
+				update.RegexRules = append(update.RegexRules, redis.String(rule.Pattern))
+			}
+		}
+	}
+
+	update.RemoteBackup, missing = toBackupConfig("remoteBackup", o.Backup, missing)
+
+	if redis.BoolValue(security.SSLClientAuthentication) || redis.BoolValue(security.TLSClientAuthentication) {
+		missing = append(missing, "clientTlsCertificates")
+	}
+
+	return update, missing
+}
+
+func (o Database) security() *Security {
+	if o.Security == nil {
+		return &Security{}
+	}
+	return o.Security
+}
+
+// ToCreate converts the Active-Active database into a CreateActiveActiveDatabase request with the same
+// configuration, e.g. to clone it. Sizing is taken from the first region, as it's the same in every region.
+//
+// Some values are never returned by the API, so the second return value lists the JSON names of the fields the
+// caller must supply themselves before the request can recreate the database faithfully.
+func (o ActiveActiveDatabase) ToCreate() (CreateActiveActiveDatabase, []string) {
+	var missing []string
+
+	create := CreateActiveActiveDatabase{
+		Name:                                o.Name,
+		Protocol:                            o.Protocol,
+		SupportOSSClusterAPI:                o.SupportOSSClusterAPI,
+		UseExternalEndpointForOSSClusterAPI: o.UseExternalEndpointForOSSClusterAPI,
+		DataEvictionPolicy:                  o.DataEvictionPolicy,
+		GlobalDataPersistence:               o.GlobalDataPersistence,
+		GlobalSourceIP:                      copyStrings(o.GlobalSourceIP),
+		GlobalPassword:                      o.GlobalPassword,
+		GlobalAlerts:                        copyAlerts(o.GlobalAlerts),
+		GlobalModules:                       copyModules(o.Modules),
+		RedisVersion:                        o.RedisVersion,
+		AutoMinorVersionUpgrade:             o.AutoMinorVersionUpgrade,
+	}
+
+	for _, crdb := range o.CrdbDatabases {
+		if crdb == nil {
+			continue
+		}
+		if create.MemoryLimitInGB == nil && create.DatasetSizeInGB == nil {
+			create.MemoryLimitInGB = crdb.MemoryLimitInGB
+			create.DatasetSizeInGB = crdb.DatasetSizeInGB
+			create.QueryPerformanceFactor = crdb.QueryPerformanceFactor
+		}
+		create.LocalThroughputMeasurement = append(create.LocalThroughputMeasurement, &LocalThroughput{
+			Region:                   crdb.Region,
+			WriteOperationsPerSecond: crdb.WriteOperationsPerSecond,
+			ReadOperationsPerSecond:  crdb.ReadOperationsPerSecond,
+		})
+	}
+
+	if o.GlobalPassword == nil {
+		missing = append(missing, "password")
+	}
+
+	return create, missing
+}
+
+// ToUpdate converts the Active-Active database into an UpdateActiveActiveDatabase request that re-applies its
+// current configuration, including the local properties of every region.
+//
+// Some values are never returned by the API, so the second return value lists the JSON names of the fields the
+// caller must supply themselves before the request can re-apply the configuration faithfully.
+func (o ActiveActiveDatabase) ToUpdate() (UpdateActiveActiveDatabase, []string) {
+	var missing []string
+	security := o.Security
+	if security == nil {
+		security = &Security{}
+	}
+
+	update := UpdateActiveActiveDatabase{
+		Name:                                o.Name,
+		SupportOSSClusterAPI:                o.SupportOSSClusterAPI,
+		UseExternalEndpointForOSSClusterAPI: o.UseExternalEndpointForOSSClusterAPI,
+		EnableTls:                           security.EnableTls,
+		GlobalDataPersistence:               o.GlobalDataPersistence,
+		GlobalPassword:                      o.GlobalPassword,
+		GlobalEnableDefaultUser:             o.GlobalEnableDefaultUser,
+		GlobalSourceIP:                      copyStrings(o.GlobalSourceIP),
+		DataEvictionPolicy:                  o.DataEvictionPolicy,
+		AutoMinorVersionUpgrade:             o.AutoMinorVersionUpgrade,
+	}
+
+	update.GlobalAlerts = redis.Set(copyAlerts(o.GlobalAlerts))
+
+	for _, crdb := range o.CrdbDatabases {
+		if crdb == nil {
+			continue
+		}
+		if update.MemoryLimitInGB == nil && update.DatasetSizeInGB == nil {
+			update.MemoryLimitInGB = crdb.MemoryLimitInGB
+			update.DatasetSizeInGB = crdb.DatasetSizeInGB
+			update.QueryPerformanceFactor = crdb.QueryPerformanceFactor
+		}
+
+		region := &LocalRegionProperties{
+			Region:          crdb.Region,
+			DataPersistence: crdb.DataPersistence,
+			LocalThroughputMeasurement: &LocalThroughput{
+				Region:                   crdb.Region,
+				WriteOperationsPerSecond: crdb.WriteOperationsPerSecond,
+				ReadOperationsPerSecond:  crdb.ReadOperationsPerSecond,
+			},
+		}
+		if crdb.Security != nil {
+			region.Password = crdb.Security.Password
+			region.SourceIP = copyStrings(crdb.Security.SourceIPs)
+			region.EnableDefaultUser = crdb.Security.EnableDefaultUser
+		}
+		region.Alerts = redis.Set(copyAlerts(crdb.Alerts))
+		region.RemoteBackup, missing = toBackupConfig("regions["+redis.StringValue(crdb.Region)+"].remoteBackup", crdb.Backup, missing)
+
+		update.Regions = append(update.Regions, region)
+	}
+
+	if redis.BoolValue(security.SSLClientAuthentication) || redis.BoolValue(security.TLSClientAuthentication) {
+		missing = append(missing, "clientTlsCertificates")
+	}
+
+	return update, missing
+}
+
+// toBackupConfig maps the backup settings of a database onto the configuration used by requests. The storage type
+// is never returned, so it's inferred from the scheme of the destination where possible.
+func toBackupConfig(field string, backup *Backup, missing []string) (*DatabaseBackupConfig, []string) {
+	if backup == nil || !redis.BoolValue(backup.Enabled) {
+		return nil, missing
+	}
+
+	config := &DatabaseBackupConfig{
+		Active:      backup.Enabled,
+		Interval:    backup.Interval,
+		TimeUTC:     backup.TimeUTC,
+		StoragePath: backup.Destination,
+		StorageType: backupStorageType(redis.StringValue(backup.Destination)),
+	}
+	if config.StorageType == nil {
+		missing = append(missing, field+".storageType")
+	}
+	return config, missing
+}
+
+func backupStorageType(destination string) *string {
+	schemes := map[string]string{
+		"s3://":   "aws-s3",
+		"gs://":   "google-blob-storage",
+		"abs://":  "azure-blob-storage",
+		"ftp://":  "ftp",
+		"ftps://": "ftp",
+	}
+	for prefix, storageType := range schemes {
+		if strings.HasPrefix(strings.ToLower(destination), prefix) {
+			return redis.String(storageType)
+		}
+	}
+	return nil
+}
+
+func copyStrings(ss []*string) []*string {
+	if ss == nil {
+		return nil
+	}
+	return redis.StringSlice(redis.StringSliceValue(ss...)...)
+}
+
+func copyAlerts(alerts []*Alert) []*Alert {
+	ret := make([]*Alert, 0, len(alerts))
+	for _, alert := range alerts {
+		if alert != nil {
+			ret = append(ret, &Alert{Name: alert.Name, Value: alert.Value})
+		}
+	}
+	return ret
+}
+
+func copyModules(modules []*Module) []*Module {
+	if modules == nil {
+		return nil
+	}
+	ret := make([]*Module, 0, len(modules))
+	for _, module := range modules {
+		if module != nil {
+			ret = append(ret, &Module{Name: module.Name})
+		}
+	}
+	return ret
+}
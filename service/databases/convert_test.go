@@ -0,0 +1,138 @@
+package databases
+
+import (
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabase_ToCreate(t *testing.T) {
+	db := Database{
+		ID:                 redis.Int(1),
+		Name:               redis.String("example"),
+		Protocol:           redis.String("redis"),
+		Status:             redis.Ptr(StatusActive),
+		DatasetSizeInGB:    redis.Float64(1),
+		DataEvictionPolicy: redis.Ptr(EvictionPolicyAllKeysLRU),
+		ThroughputMeasurement: &Throughput{
+			By:    redis.String("operations-per-second"),
+			Value: redis.Int(1000),
+		},
+		ReplicaOf: &ReplicaOf{Endpoints: redis.StringSlice("redis://a:6379")},
+		Security: &Security{
+			SourceIPs:               redis.StringSlice("10.0.0.0/24"),
+			TLSClientAuthentication: redis.Bool(true),
+			EnableTls:               redis.Bool(true),
+		},
+		Modules: []*Module{{Name: redis.String("RediSearch")}},
+		Backup: &Backup{
+			Enabled:     redis.Bool(true),
+			Interval:    redis.String(BackupIntervalEvery6Hours),
+			Destination: redis.String("somewhere-else"),
+		},
+	}
+
+	create, missing := db.ToCreate()
+
+	assert.Equal(t, CreateDatabase{
+		Name:               redis.String("example"),
+		Protocol:           redis.String("redis"),
+		DatasetSizeInGB:    redis.Float64(1),
+		DataEvictionPolicy: redis.Ptr(EvictionPolicyAllKeysLRU),
+		ThroughputMeasurement: &CreateThroughputMeasurement{
+			By:    redis.String("operations-per-second"),
+			Value: redis.Int(1000),
+		},
+		ReplicaOf: redis.StringSlice("redis://a:6379"),
+		SourceIP:  redis.StringSlice("10.0.0.0/24"),
+		EnableTls: redis.Bool(true),
+		Alerts:    []*Alert{},
+		Modules:   []*Module{{Name: redis.String("RediSearch")}},
+		RemoteBackup: &DatabaseBackupConfig{
+			Active:      redis.Bool(true),
+			Interval:    redis.String(BackupIntervalEvery6Hours),
+			StoragePath: redis.String("somewhere-else"),
+		},
+	}, create)
+	assert.Equal(t, []string{"remoteBackup.storageType", "password", "clientTlsCertificates"}, missing)
+}
+
+func TestDatabase_ToUpdate(t *testing.T) {
+	db := Database{
+		Name:   redis.String("example"),
+		Alerts: []*Alert{{Name: redis.String("dataset-size"), Value: redis.Int(80)}},
+		Clustering: &Clustering{
+			RegexRules: []*RegexRule{{Ordinal: 0, Pattern: ".*\\{(?<tag>.*)\\}.*"}},
+		},
+		Security: &Security{
+			Password:          redis.String("password"),
+			EnableDefaultUser: redis.Bool(true),
+		},
+		Backup: &Backup{
+			Enabled:     redis.Bool(true),
+			Destination: redis.String("s3://bucket/path"),
+		},
+	}
+
+	update, missing := db.ToUpdate()
+
+	assert.Equal(t, UpdateDatabase{
+		Name:              redis.String("example"),
+		RegexRules:        redis.StringSlice(".*\\{(?<tag>.*)\\}.*"),
+		Password:          redis.String("password"),
+		EnableDefaultUser: redis.Bool(true),
+		Alerts:            redis.Set([]*Alert{{Name: redis.String("dataset-size"), Value: redis.Int(80)}}),
+		RemoteBackup: &DatabaseBackupConfig{
+			Active:      redis.Bool(true),
+			StorageType: redis.String("aws-s3"),
+			StoragePath: redis.String("s3://bucket/path"),
+		},
+	}, update)
+	assert.Empty(t, missing)
+
+	// Round-tripping the update through Diff shouldn't find anything to change
+	_, changes := Diff(&db, update)
+	assert.Empty(t, changes)
+}
+
+func TestActiveActiveDatabase_ToUpdate(t *testing.T) {
+	db := ActiveActiveDatabase{
+		Name:           redis.String("example"),
+		GlobalPassword: redis.String("password"),
+		CrdbDatabases: []*CrdbDatabase{
+			{
+				Region:                   redis.String("us-east-1"),
+				DatasetSizeInGB:          redis.Float64(1),
+				ReadOperationsPerSecond:  redis.Int(1000),
+				WriteOperationsPerSecond: redis.Int(2000),
+				Security:                 &Security{SourceIPs: redis.StringSlice("0.0.0.0/0")},
+			},
+		},
+	}
+
+	update, missing := db.ToUpdate()
+
+	assert.Equal(t, UpdateActiveActiveDatabase{
+		Name:            redis.String("example"),
+		DatasetSizeInGB: redis.Float64(1),
+		GlobalPassword:  redis.String("password"),
+		GlobalAlerts:    redis.Set([]*Alert{}),
+		Regions: []*LocalRegionProperties{
+			{
+				Region: redis.String("us-east-1"),
+				LocalThroughputMeasurement: &LocalThroughput{
+					Region:                   redis.String("us-east-1"),
+					WriteOperationsPerSecond: redis.Int(2000),
+					ReadOperationsPerSecond:  redis.Int(1000),
+				},
+				SourceIP: redis.StringSlice("0.0.0.0/0"),
+				Alerts:   redis.Set([]*Alert{}),
+			},
+		},
+	}, update)
+	assert.Empty(t, missing)
+
+	_, changes := DiffActiveActive(&db, update)
+	assert.Empty(t, changes)
+}
@@ -0,0 +1,267 @@
+package databases
+
+import (
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+)
+
+// Change describes a single field that differs between a live database and its desired configuration. Field uses the
+// JSON name of the field in the update request.
+type Change struct {
+	Field string
+	From  interface{}
+	To    interface{}
+	// Sensitive changes (e.g. passwords) never have their values rendered by String.
+	Sensitive bool
+	// WriteOnly changes are for fields the API never returns, so they are always sent when desired.
+	WriteOnly bool
+}
+
+func (c Change) String() string {
+	return internal.FieldChange(c).String()
+}
+
+// Changes is the human-readable diff produced alongside a minimal update request.
+type Changes []*Change
+
+func (c Changes) String() string {
+	lines := make([]string, 0, len(c))
+	for _, change := range c {
+		lines = append(lines, change.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// NewChanges converts the changes recorded by a differ - shared with the fixed databases package.
+func NewChanges(d *internal.Differ) Changes {
+	ret := make(Changes, 0, len(d.Changes))
+	for _, change := range d.Changes {
+		c := Change(*change)
+		ret = append(ret, &c)
+	}
+	return ret
+}
+
+// Diff compares a live database against the desired configuration, expressed as an UpdateDatabase, and returns the
+// minimal UpdateDatabase needed to reach it along with a description of every change. Fields left nil in desired,
+// including those of nested objects such as RemoteBackup, are treated as "don't care" and never appear in the result.
+func Diff(live *Database, desired UpdateDatabase) (UpdateDatabase, Changes) {
+	d := &internal.Differ{}
+	if live == nil {
+		live = &Database{}
+	}
+	security := live.Security
+	if security == nil {
+		security = &Security{}
+	}
+
+	update := UpdateDatabase{
+		DryRun:                              desired.DryRun,
+		Name:                                internal.DiffValue(d, "name", live.Name, desired.Name),
+		MemoryLimitInGB:                     internal.DiffValue(d, "memoryLimitInGb", live.MemoryLimitInGB, desired.MemoryLimitInGB),
+		DatasetSizeInGB:                     internal.DiffValue(d, "datasetSizeInGb", live.DatasetSizeInGB, desired.DatasetSizeInGB),
+		SupportOSSClusterAPI:                internal.DiffValue(d, "supportOSSClusterApi", live.SupportOSSClusterAPI, desired.SupportOSSClusterAPI),
+		RespVersion:                         internal.DiffValue(d, "respVersion", live.RespVersion, desired.RespVersion),
+		UseExternalEndpointForOSSClusterAPI: internal.DiffWriteOnly(d, "useExternalEndpointForOSSClusterApi", desired.UseExternalEndpointForOSSClusterAPI, false),
+		DataEvictionPolicy:                  internal.DiffValue(d, "dataEvictionPolicy", live.DataEvictionPolicy, desired.DataEvictionPolicy),
+		Replication:                         internal.DiffValue(d, "replication", live.Replication, desired.Replication),
+		ThroughputMeasurement:               diffThroughput(d, live.ThroughputMeasurement, desired.ThroughputMeasurement),
+		RegexRules:                          diffRegexRules(d, live.Clustering, desired.RegexRules),
+		DataPersistence:                     internal.DiffValue(d, "dataPersistence", live.DataPersistence, desired.DataPersistence),
+		ReplicaOf:                           diffReplicaOf(d, live.ReplicaOf, desired.ReplicaOf),
+		PeriodicBackupPath:                  internal.DiffValue(d, "periodicBackupPath", backupDestination(live.Backup), desired.PeriodicBackupPath),
+		SourceIP:                            internal.DiffStrings(d, "sourceIp", security.SourceIPs, desired.SourceIP),
+		ClientSSLCertificate:                internal.DiffWriteOnly(d, "clientSslCertificate", desired.ClientSSLCertificate, false),
+		ClientTLSCertificates:               internal.DiffWriteOnlyOptional(d, "clientTlsCertificates", desired.ClientTLSCertificates, false),
+		Password:                            internal.DiffSecret(d, "password", security.Password, desired.Password),
+		Alerts:                              diffAlerts(d, "alerts", live.Alerts, desired.Alerts),
+		EnableTls:                           internal.DiffValue(d, "enableTls", security.EnableTls, desired.EnableTls),
+		RemoteBackup:                        diffBackup(d, "remoteBackup", live.Backup, desired.RemoteBackup),
+		EnableDefaultUser:                   internal.DiffValue(d, "enableDefaultUser", security.EnableDefaultUser, desired.EnableDefaultUser),
+		QueryPerformanceFactor:              internal.DiffValue(d, "queryPerformanceFactor", live.QueryPerformanceFactor, desired.QueryPerformanceFactor),
+		AutoMinorVersionUpgrade:             internal.DiffValue(d, "autoMinorVersionUpgrade", live.AutoMinorVersionUpgrade, desired.AutoMinorVersionUpgrade),
+		RamPercentage:                       internal.DiffValue(d, "ramPercentage", live.RamPercentage, desired.RamPercentage),
+	}
+
+	return update, NewChanges(d)
+}
+
+// DiffActiveActive compares a live Active-Active database against the desired configuration, expressed as an
+// UpdateActiveActiveDatabase, and returns the minimal update needed to reach it along with a description of every
+// change. Regional properties are matched to the live CrdbDatabases by region name, and a region is only included
+// in the result when at least one of its properties differs.
+func DiffActiveActive(live *ActiveActiveDatabase, desired UpdateActiveActiveDatabase) (UpdateActiveActiveDatabase, Changes) {
+	d := &internal.Differ{}
+	if live == nil {
+		live = &ActiveActiveDatabase{}
+	}
+	security := live.Security
+	if security == nil {
+		security = &Security{}
+	}
+
+	update := UpdateActiveActiveDatabase{
+		DryRun:                              desired.DryRun,
+		Name:                                internal.DiffValue(d, "name", live.Name, desired.Name),
+		MemoryLimitInGB:                     diffRegional(d, "memoryLimitInGb", live.CrdbDatabases, func(c *CrdbDatabase) *float64 { return c.MemoryLimitInGB }, desired.MemoryLimitInGB),
+		DatasetSizeInGB:                     diffRegional(d, "datasetSizeInGb", live.CrdbDatabases, func(c *CrdbDatabase) *float64 { return c.DatasetSizeInGB }, desired.DatasetSizeInGB),
+		SupportOSSClusterAPI:                internal.DiffValue(d, "supportOSSClusterApi", live.SupportOSSClusterAPI, desired.SupportOSSClusterAPI),
+		UseExternalEndpointForOSSClusterAPI: internal.DiffValue(d, "useExternalEndpointForOSSClusterApi", live.UseExternalEndpointForOSSClusterAPI, desired.UseExternalEndpointForOSSClusterAPI),
+		ClientSSLCertificate:                internal.DiffWriteOnly(d, "clientSslCertificate", desired.ClientSSLCertificate, false),
+		ClientTLSCertificates:               internal.DiffWriteOnlyOptional(d, "clientTlsCertificates", desired.ClientTLSCertificates, false),
+		EnableTls:                           internal.DiffValue(d, "enableTls", security.EnableTls, desired.EnableTls),
+		GlobalDataPersistence:               internal.DiffValue(d, "globalDataPersistence", live.GlobalDataPersistence, desired.GlobalDataPersistence),
+		GlobalPassword:                      internal.DiffSecret(d, "globalPassword", live.GlobalPassword, desired.GlobalPassword),
+		GlobalEnableDefaultUser:             internal.DiffValue(d, "globalEnableDefaultUser", live.GlobalEnableDefaultUser, desired.GlobalEnableDefaultUser),
+		GlobalSourceIP:                      internal.DiffStrings(d, "globalSourceIp", live.GlobalSourceIP, desired.GlobalSourceIP),
+		GlobalAlerts:                        diffAlerts(d, "globalAlerts", live.GlobalAlerts, desired.GlobalAlerts),
+		DataEvictionPolicy:                  internal.DiffValue(d, "dataEvictionPolicy", live.DataEvictionPolicy, desired.DataEvictionPolicy),
+		QueryPerformanceFactor:              diffRegional(d, "queryPerformanceFactor", live.CrdbDatabases, func(c *CrdbDatabase) *string { return c.QueryPerformanceFactor }, desired.QueryPerformanceFactor),
+		AutoMinorVersionUpgrade:             internal.DiffValue(d, "autoMinorVersionUpgrade", live.AutoMinorVersionUpgrade, desired.AutoMinorVersionUpgrade),
+	}
+
+	for _, region := range desired.Regions {
+		if region == nil {
+			continue
+		}
+		if changed := diffLocalRegion(d, findCrdbDatabase(live.CrdbDatabases, region.Region), region); changed != nil {
+			update.Regions = append(update.Regions, changed)
+		}
+	}
+
+	return update, NewChanges(d)
+}
+
+func diffLocalRegion(parent *internal.Differ, live *CrdbDatabase, desired *LocalRegionProperties) *LocalRegionProperties {
+	d := parent.Nested("regions[" + redis.StringValue(desired.Region) + "]")
+	if live == nil {
+		live = &CrdbDatabase{}
+	}
+	security := live.Security
+	if security == nil {
+		security = &Security{}
+	}
+
+	update := &LocalRegionProperties{
+		Region:            desired.Region,
+		RemoteBackup:      diffBackup(d, "remoteBackup", live.Backup, desired.RemoteBackup),
+		DataPersistence:   internal.DiffValue(d, "dataPersistence", live.DataPersistence, desired.DataPersistence),
+		Password:          internal.DiffSecret(d, "password", security.Password, desired.Password),
+		SourceIP:          internal.DiffStrings(d, "sourceIp", security.SourceIPs, desired.SourceIP),
+		EnableDefaultUser: internal.DiffValue(d, "enableDefaultUser", security.EnableDefaultUser, desired.EnableDefaultUser),
+		Alerts:            diffAlerts(d, "alerts", live.Alerts, desired.Alerts),
+	}
+
+	if desired.LocalThroughputMeasurement != nil {
+		liveThroughput := &LocalThroughput{
+			Region:                   desired.Region,
+			WriteOperationsPerSecond: live.WriteOperationsPerSecond,
+			ReadOperationsPerSecond:  live.ReadOperationsPerSecond,
+		}
+		update.LocalThroughputMeasurement = internal.DiffNested(d, "localThroughputMeasurement", liveThroughput, desired.LocalThroughputMeasurement)
+	}
+
+	if !d.HasChanges() {
+		return nil
+	}
+	parent.Merge(d)
+	return update
+}
+
+func findCrdbDatabase(crdbs []*CrdbDatabase, region *string) *CrdbDatabase {
+	for _, crdb := range crdbs {
+		if crdb != nil && redis.StringValue(crdb.Region) == redis.StringValue(region) {
+			return crdb
+		}
+	}
+	return nil
+}
+
+// diffRegional compares a global desired value against the value reported by every region, as the API only returns
+// some global settings per region.
+func diffRegional[T comparable](d *internal.Differ, field string, crdbs []*CrdbDatabase, get func(*CrdbDatabase) *T, desired *T) *T {
+	if desired == nil {
+		return nil
+	}
+	var live *T
+	for _, crdb := range crdbs {
+		if crdb == nil {
+			continue
+		}
+		value := get(crdb)
+		if value == nil || *value != *desired {
+			return internal.DiffValue(d, field, value, desired)
+		}
+		live = value
+	}
+	return internal.DiffValue(d, field, live, desired)
+}
+
+func diffThroughput(d *internal.Differ, live *Throughput, desired *UpdateThroughputMeasurement) *UpdateThroughputMeasurement {
+	if desired == nil {
+		return nil
+	}
+	var asUpdate *UpdateThroughputMeasurement
+	if live != nil {
+		asUpdate = &UpdateThroughputMeasurement{By: live.By, Value: live.Value}
+	}
+	return internal.DiffNested(d, "throughputMeasurement", asUpdate, desired)
+}
+
+func diffRegexRules(d *internal.Differ, live *Clustering, desired []*string) []*string {
+	if desired == nil {
+		return nil
+	}
+	var patterns []*string
+	if live != nil {
+		for _, rule := range live.RegexRules {
+			if rule != nil {
+				patterns = append(patterns, redis.String(rule.Pattern))
+			}
+		}
+	}
+	// Ordering matters for regex rules, so they are compared as a list rather than a set
+	if changed := internal.DiffDeep(d, "regexRules", &patterns, &desired); changed != nil {
+		return *changed
+	}
+	return nil
+}
+
+func diffReplicaOf(d *internal.Differ, live *ReplicaOf, desired redis.Optional[[]*string]) redis.Optional[[]*string] {
+	var endpoints []*string
+	if live != nil {
+		endpoints = live.Endpoints
+	}
+	return internal.DiffOptionalStrings(d, "replicaOf", endpoints, desired)
+}
+
+func diffAlerts(d *internal.Differ, field string, live []*Alert, desired redis.Optional[[]*Alert]) redis.Optional[[]*Alert] {
+	return internal.DiffOptional(d, field, &live, desired)
+}
+
+func diffBackup(d *internal.Differ, field string, live *Backup, desired *DatabaseBackupConfig) *DatabaseBackupConfig {
+	if desired == nil {
+		return nil
+	}
+	asConfig := &DatabaseBackupConfig{}
+	if live != nil {
+		asConfig = &DatabaseBackupConfig{
+			Active:      live.Enabled,
+			Interval:    live.Interval,
+			TimeUTC:     live.TimeUTC,
+			StoragePath: live.Destination,
+		}
+	}
+	// The storage type is never returned, so it's assumed to be unchanged
+	asConfig.StorageType = desired.StorageType
+	return internal.DiffNested(d, field, asConfig, desired)
+}
+
+func backupDestination(backup *Backup) *string {
+	if backup == nil {
+		return nil
+	}
+	return backup.Destination
+}
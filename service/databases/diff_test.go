@@ -0,0 +1,189 @@
+package databases
+
+import (
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff_onlyIncludesChangedFields(t *testing.T) {
+	live := &Database{
+		Name:               redis.String("example"),
+		MemoryLimitInGB:    redis.Float64(1),
+		DataEvictionPolicy: redis.Ptr(EvictionPolicyAllKeysLRU),
+		ReplicaOf:          &ReplicaOf{Endpoints: redis.StringSlice("redis://a:6379")},
+		Security: &Security{
+			SourceIPs:         redis.StringSlice("10.0.0.0/24", "10.1.0.0/24"),
+			Password:          redis.String("old"),
+			EnableDefaultUser: redis.Bool(true),
+		},
+		Alerts: []*Alert{{Name: redis.String("dataset-size"), Value: redis.Int(80)}},
+		Backup: &Backup{
+			Enabled:     redis.Bool(true),
+			Interval:    redis.String(BackupIntervalEvery24Hours),
+			Destination: redis.String("s3://bucket"),
+		},
+	}
+
+	update, changes := Diff(live, UpdateDatabase{
+		Name:               redis.String("example"),
+		MemoryLimitInGB:    redis.Float64(2),
+		DataEvictionPolicy: redis.Ptr(EvictionPolicyAllKeysLRU),
+		SourceIP:           redis.StringSlice("10.1.0.0/24", "10.0.0.0/24"),
+		Password:           redis.String("new"),
+		EnableDefaultUser:  redis.Bool(false),
+		Alerts:             redis.Set([]*Alert{{Name: redis.String("dataset-size"), Value: redis.Int(80)}}),
+		RemoteBackup: &DatabaseBackupConfig{
+			Active:      redis.Bool(true),
+			Interval:    redis.String(BackupIntervalEvery12Hours),
+			StoragePath: redis.String("s3://bucket"),
+		},
+	})
+
+	assert.Equal(t, UpdateDatabase{
+		MemoryLimitInGB:   redis.Float64(2),
+		Password:          redis.String("new"),
+		EnableDefaultUser: redis.Bool(false),
+		RemoteBackup: &DatabaseBackupConfig{
+			Active:      redis.Bool(true),
+			Interval:    redis.String(BackupIntervalEvery12Hours),
+			StoragePath: redis.String("s3://bucket"),
+		},
+	}, update)
+
+	assert.Equal(t, `memoryLimitInGb: 1 -> 2
+password: (sensitive value changed)
+remoteBackup: map[active:true interval:every-24-hours storagePath:s3://bucket] -> map[active:true interval:every-12-hours storagePath:s3://bucket]
+enableDefaultUser: true -> false`, changes.String())
+}
+
+func TestDiff_noChanges(t *testing.T) {
+	update, changes := Diff(&Database{Name: redis.String("example")}, UpdateDatabase{Name: redis.String("example")})
+
+	assert.Equal(t, UpdateDatabase{}, update)
+	assert.Empty(t, changes)
+}
+
+func TestDiff_partialNestedValueOnlyComparesSetFields(t *testing.T) {
+	live := &Database{
+		ThroughputMeasurement: &Throughput{By: redis.String("operations-per-second"), Value: redis.Int(1000)},
+		Backup: &Backup{
+			Enabled:     redis.Bool(true),
+			Interval:    redis.String(BackupIntervalEvery24Hours),
+			TimeUTC:     redis.String("14:00"),
+			Destination: redis.String("s3://bucket"),
+		},
+	}
+
+	update, changes := Diff(live, UpdateDatabase{
+		ThroughputMeasurement: &UpdateThroughputMeasurement{Value: redis.Int(1000)},
+		RemoteBackup:          &DatabaseBackupConfig{Active: redis.Bool(true)},
+	})
+
+	assert.Equal(t, UpdateDatabase{}, update)
+	assert.Empty(t, changes)
+
+	update, changes = Diff(live, UpdateDatabase{
+		RemoteBackup: &DatabaseBackupConfig{Interval: redis.String(BackupIntervalEvery12Hours)},
+	})
+
+	assert.Equal(t, UpdateDatabase{
+		RemoteBackup: &DatabaseBackupConfig{Interval: redis.String(BackupIntervalEvery12Hours)},
+	}, update)
+	assert.Equal(t, `remoteBackup: map[active:true interval:every-24-hours storagePath:s3://bucket timeUTC:14:00] -> map[interval:every-12-hours]`, changes.String())
+}
+
+func TestDiff_nullClearsField(t *testing.T) {
+	live := &Database{
+		ReplicaOf: &ReplicaOf{Endpoints: redis.StringSlice("redis://a:6379")},
+		Alerts:    []*Alert{{Name: redis.String("dataset-size"), Value: redis.Int(80)}},
+	}
+
+	update, changes := Diff(live, UpdateDatabase{
+		ReplicaOf: redis.Null[[]*string](),
+		Alerts:    redis.Set([]*Alert{}),
+	})
+
+	assert.Equal(t, UpdateDatabase{
+		ReplicaOf: redis.Null[[]*string](),
+		Alerts:    redis.Set([]*Alert{}),
+	}, update)
+	assert.Len(t, changes, 2)
+
+	update, changes = Diff(&Database{}, UpdateDatabase{ReplicaOf: redis.Null[[]*string]()})
+
+	assert.Equal(t, UpdateDatabase{}, update)
+	assert.Empty(t, changes)
+}
+
+func TestDiff_writeOnlyFieldsAreAlwaysSent(t *testing.T) {
+	update, changes := Diff(&Database{}, UpdateDatabase{
+		ClientSSLCertificate: redis.String("cert"),
+	})
+
+	assert.Equal(t, redis.String("cert"), update.ClientSSLCertificate)
+	assert.Equal(t, Changes{
+		{Field: "clientSslCertificate", To: "cert", WriteOnly: true},
+	}, changes)
+	assert.Equal(t, `clientSslCertificate: (not returned by the API) -> "cert"`, changes.String())
+}
+
+func TestDiffActiveActive_comparesRegionsByName(t *testing.T) {
+	live := &ActiveActiveDatabase{
+		Name:           redis.String("example"),
+		GlobalPassword: redis.String("secret"),
+		CrdbDatabases: []*CrdbDatabase{
+			{
+				Region:                   redis.String("us-east-1"),
+				MemoryLimitInGB:          redis.Float64(1),
+				DataPersistence:          redis.Ptr(DataPersistenceNone),
+				ReadOperationsPerSecond:  redis.Int(1000),
+				WriteOperationsPerSecond: redis.Int(1000),
+			},
+			{
+				Region:                   redis.String("eu-west-1"),
+				MemoryLimitInGB:          redis.Float64(1),
+				DataPersistence:          redis.Ptr(DataPersistenceNone),
+				ReadOperationsPerSecond:  redis.Int(1000),
+				WriteOperationsPerSecond: redis.Int(1000),
+			},
+		},
+	}
+
+	update, changes := DiffActiveActive(live, UpdateActiveActiveDatabase{
+		MemoryLimitInGB: redis.Float64(1),
+		GlobalPassword:  redis.String("secret"),
+		Regions: []*LocalRegionProperties{
+			{
+				Region:          redis.String("us-east-1"),
+				DataPersistence: redis.Ptr(DataPersistenceNone),
+			},
+			{
+				Region:          redis.String("eu-west-1"),
+				DataPersistence: redis.Ptr(DataPersistenceAOFEvery1Second),
+				LocalThroughputMeasurement: &LocalThroughput{
+					Region:                   redis.String("eu-west-1"),
+					WriteOperationsPerSecond: redis.Int(2000),
+					ReadOperationsPerSecond:  redis.Int(1000),
+				},
+			},
+		},
+	})
+
+	assert.Equal(t, UpdateActiveActiveDatabase{
+		Regions: []*LocalRegionProperties{
+			{
+				Region:          redis.String("eu-west-1"),
+				DataPersistence: redis.Ptr(DataPersistenceAOFEvery1Second),
+				LocalThroughputMeasurement: &LocalThroughput{
+					Region:                   redis.String("eu-west-1"),
+					WriteOperationsPerSecond: redis.Int(2000),
+					ReadOperationsPerSecond:  redis.Int(1000),
+				},
+			},
+		},
+	}, update)
+	assert.Equal(t, `regions[eu-west-1].dataPersistence: "none" -> "aof-every-1-second"
+regions[eu-west-1].localThroughputMeasurement: map[readOperationsPerSecond:1000 region:eu-west-1 writeOperationsPerSecond:1000] -> map[readOperationsPerSecond:1000 region:eu-west-1 writeOperationsPerSecond:2000]`, changes.String())
+}
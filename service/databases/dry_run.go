@@ -0,0 +1,58 @@
+package databases
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+)
+
+// DryRunCreate checks whether the database could be created for the subscription, and what it would cost, without
+// creating it. The API refusing the request is reported by the result rather than as an error.
+func (a *API) DryRunCreate(ctx context.Context, subscription int, db CreateDatabase) (*pricing.DryRunResult, error) {
+	db.DryRun = redis.Bool(true)
+	return a.dryRun(ctx, http.MethodPost, fmt.Sprintf("dry run creating database for subscription %d", subscription), fmt.Sprintf("/subscriptions/%d/databases", subscription), db)
+}
+
+// DryRunUpdate checks whether the database could be updated, and what it would then cost, without updating it.
+func (a *API) DryRunUpdate(ctx context.Context, subscription int, database int, update UpdateDatabase) (*pricing.DryRunResult, error) {
+	update.DryRun = redis.Bool(true)
+	return a.dryRun(ctx, http.MethodPut, fmt.Sprintf("dry run updating database %d for subscription %d", database, subscription), fmt.Sprintf("/subscriptions/%d/databases/%d", subscription, database), update)
+}
+
+// ActiveActiveDryRunCreate checks whether the Active-Active database could be created for the subscription, and what
+// it would cost, without creating it.
+func (a *API) ActiveActiveDryRunCreate(ctx context.Context, subscription int, db CreateActiveActiveDatabase) (*pricing.DryRunResult, error) {
+	db.DryRun = redis.Bool(true)
+	return a.dryRun(ctx, http.MethodPost, fmt.Sprintf("dry run creating database for subscription %d", subscription), fmt.Sprintf("/subscriptions/%d/databases", subscription), db)
+}
+
+// ActiveActiveDryRunUpdate checks whether the Active-Active database could be updated, and what it would then cost,
+// without updating it.
+func (a *API) ActiveActiveDryRunUpdate(ctx context.Context, subscription int, database int, update UpdateActiveActiveDatabase) (*pricing.DryRunResult, error) {
+	update.DryRun = redis.Bool(true)
+	return a.dryRun(ctx, http.MethodPut, fmt.Sprintf("dry run updating database %d for subscription %d", database, subscription), fmt.Sprintf("/subscriptions/%d/databases/%d/regions", subscription, database), update)
+}
+
+func (a *API) dryRun(ctx context.Context, method string, name string, path string, request interface{}) (*pricing.DryRunResult, error) {
+	var task internal.TaskResponse
+	var err error
+	if method == http.MethodPut {
+		err = a.client.Put(ctx, name, path, request, &task)
+	} else {
+		err = a.client.Post(ctx, name, path, request, &task)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var resource pricing.ListPricingResponse
+	refusal, err := internal.WaitForDryRun(ctx, a.taskWaiter.WaitForResource, *task.ID, &resource)
+	if err != nil {
+		return nil, err
+	}
+	return pricing.NewDryRunResult(refusal, resource), nil
+}
@@ -0,0 +1,108 @@
+package databases
+
+import "slices"
+
+// Status is the value of the `Status` field in `Database`. Values introduced by the API after this version of the
+// library are unmarshalled as-is rather than rejected, and report false from IsValid.
+type Status string
+
+const (
+	// StatusActive is the active value of the `Status` field in `Database`
+	StatusActive Status = "active"
+	// StatusDraft is the draft value of the `Status` field in `Database`
+	StatusDraft Status = "draft"
+	// StatusPending is the pending value of the `Status` field in `Database`
+	StatusPending Status = "pending"
+	// StatusRCPChangePending is the RCP change pending value of the `Status` field in `Database`
+	StatusRCPChangePending Status = "rcp-change-pending"
+	// StatusRCPDraft is the RCP draft value of the `Status` field in `Database`
+	StatusRCPDraft Status = "rcp-draft"
+	// StatusRCPActiveChangeDraft is the RCP active change draft value of the `Status` field in `Database`
+	StatusRCPActiveChangeDraft Status = "rcp-active-change-draft"
+	// StatusActiveChangeDraft is the Active change draft value of the `Status` field in `Database`
+	StatusActiveChangeDraft Status = "active-change-draft"
+	// StatusActiveChangePending is the Active change pending value of the `Status` field in `Database`
+	StatusActiveChangePending Status = "active-change-pending"
+	// StatusDynamicEndpointsCreationPending is the Dynamic endpoints creation pending value of the `Status` field in `Database`
+	StatusDynamicEndpointsCreationPending Status = "dynamic-endpoints-creation-pending"
+	StatusActiveUpgradePending            Status = "active-upgrade-pending"
+	// StatusProxyPolicyChangePending and StatusProxyPolicyChangeDraft
+	// The below two Proxy Policy states are caused by a change to the 'support_oss_cluster_api' attribute
+	// StatusProxyPolicyChangePending is the Proxy Policy change pending value of the `Status` field in `Database`.
+	StatusProxyPolicyChangePending Status = "proxy-policy-change-pending"
+	// StatusProxyPolicyChangeDraft is the Proxy Policy change draft value of the `Status` field in `Database`
+	StatusProxyPolicyChangeDraft Status = "proxy-policy-change-draft"
+
+	// StatusError is the error value of the `Status` field in `Database`
+	StatusError Status = "error"
+)
+
+func StatusValues() []Status {
+	return []Status{
+		StatusActive,
+		StatusDraft,
+		StatusPending,
+		StatusRCPChangePending,
+		StatusRCPDraft,
+		StatusRCPActiveChangeDraft,
+		StatusActiveChangeDraft,
+		StatusActiveChangePending,
+		StatusDynamicEndpointsCreationPending,
+		StatusActiveUpgradePending,
+		StatusProxyPolicyChangePending,
+		StatusProxyPolicyChangeDraft,
+		StatusError,
+	}
+}
+
+// IsValid reports whether the status is one known to this version of the library.
+func (s Status) IsValid() bool {
+	return slices.Contains(StatusValues(), s)
+}
+
+// IsTerminal reports whether the database has settled, i.e. it won't change status again without another request.
+func (s Status) IsTerminal() bool {
+	return s == StatusActive || s == StatusError
+}
+
+// IsError reports whether the database has failed.
+func (s Status) IsError() bool {
+	return s == StatusError
+}
+
+// EvictionPolicy is the value of the `DataEvictionPolicy` field of databases, deciding which keys are evicted once the
+// memory limit is reached.
+type EvictionPolicy string
+
+const (
+	EvictionPolicyAllKeysLRU     EvictionPolicy = "allkeys-lru"
+	EvictionPolicyAllKeysLFU     EvictionPolicy = "allkeys-lfu"
+	EvictionPolicyAllKeysRandom  EvictionPolicy = "allkeys-random"
+	EvictionPolicyVolatileLRU    EvictionPolicy = "volatile-lru"
+	EvictionPolicyVolatileLFU    EvictionPolicy = "volatile-lfu"
+	EvictionPolicyVolatileRandom EvictionPolicy = "volatile-random"
+	EvictionPolicyVolatileTTL    EvictionPolicy = "volatile-ttl"
+	EvictionPolicyNoEviction     EvictionPolicy = "noeviction"
+)
+
+// IsValid reports whether the eviction policy is one known to this version of the library.
+func (p EvictionPolicy) IsValid() bool {
+	return slices.Contains(DataEvictionPolicyValues(), string(p))
+}
+
+// DataPersistence is the value of the `DataPersistence` field of databases, deciding how data is written to disk.
+type DataPersistence string
+
+const (
+	DataPersistenceNone                 DataPersistence = "none"
+	DataPersistenceAOFEvery1Second      DataPersistence = "aof-every-1-second"
+	DataPersistenceAOFEveryWrite        DataPersistence = "aof-every-write"
+	DataPersistenceSnapshotEvery1Hour   DataPersistence = "snapshot-every-1-hour"
+	DataPersistenceSnapshotEvery6Hours  DataPersistence = "snapshot-every-6-hours"
+	DataPersistenceSnapshotEvery12Hours DataPersistence = "snapshot-every-12-hours"
+)
+
+// IsValid reports whether the persistence option is one known to this version of the library.
+func (p DataPersistence) IsValid() bool {
+	return slices.Contains(DataPersistenceValues(), string(p))
+}
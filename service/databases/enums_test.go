@@ -0,0 +1,44 @@
+package databases
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus_unknownValueRoundTrips(t *testing.T) {
+	var db Database
+	require.NoError(t, json.Unmarshal([]byte(`{"status":"brand-new-status","dataEvictionPolicy":"allkeys-lru"}`), &db))
+
+	assert.Equal(t, Status("brand-new-status"), *db.Status)
+	assert.False(t, db.Status.IsValid())
+	assert.False(t, db.Status.IsTerminal())
+	assert.True(t, db.DataEvictionPolicy.IsValid())
+
+	actual, err := json.Marshal(db)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":"brand-new-status","dataEvictionPolicy":"allkeys-lru"}`, string(actual))
+}
+
+func TestStatus_IsTerminal(t *testing.T) {
+	assert.True(t, StatusActive.IsTerminal())
+	assert.True(t, StatusError.IsTerminal())
+	assert.True(t, StatusError.IsError())
+	assert.False(t, StatusPending.IsTerminal())
+	assert.False(t, StatusActive.IsError())
+}
+
+func TestEnums_valuesAreValid(t *testing.T) {
+	for _, value := range StatusValues() {
+		assert.True(t, value.IsValid(), value)
+	}
+	for _, value := range DataPersistenceValues() {
+		assert.True(t, DataPersistence(value).IsValid(), value)
+	}
+	for _, value := range DataEvictionPolicyValues() {
+		assert.True(t, EvictionPolicy(value).IsValid(), value)
+	}
+	assert.False(t, EvictionPolicy("lru").IsValid())
+}
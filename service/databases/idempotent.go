@@ -0,0 +1,94 @@
+package databases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+)
+
+// ErrNoIdempotencyKey is returned by CreateIdempotent when the database has no name to identify it by.
+var ErrNoIdempotencyKey = errors.New("the database name is needed to create it idempotently")
+
+// CreateIdempotent creates a database like Create, unless it has already been created. The database name, unique within
+// a subscription, is its idempotency key: when a previous call with the same name is still being processed by this
+// client, whether concurrently or e.g. it returned after its context timed out, the call waits for its task, and when a
+// database of the subscription already has the name, its identifier is returned. Either way, no duplicate is created,
+// and retrying CreateIdempotent after any error is safe. When the call options leave the task processing, a
+// *rediscloud_api.NotWaitedError is returned and the name stays reserved, so the next call waits for the same task.
+func (a *API) CreateIdempotent(ctx context.Context, subscription int, db CreateDatabase) (int, error) {
+	if db.Name == nil {
+		return 0, ErrNoIdempotencyKey
+	}
+	key := fmt.Sprintf("%d/%s", subscription, *db.Name)
+
+	for {
+		reservation, owner := a.inFlight.Reserve(key)
+		if owner {
+			return a.createReserved(ctx, reservation, subscription, db)
+		}
+
+		taskID, ok, err := reservation.Task(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			// The other call didn't create the database, so this one tries to
+			continue
+		}
+
+		internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for database %s for subscription %d to finish being created", *db.Name, subscription), internal.SubscriptionID(subscription), internal.TaskID(&taskID))
+
+		id, err := reservation.Wait(ctx, a.taskWaiter.WaitForResourceId)
+		if err == nil || !internal.IsTaskFailure(err) {
+			return id, err
+		}
+		// Nothing was created by the failed task, so the database is created again
+	}
+}
+
+// createReserved creates the database once its name has been reserved, unless the subscription already has it.
+func (a *API) createReserved(ctx context.Context, reservation *internal.Reservation, subscription int, db CreateDatabase) (int, error) {
+	if id, ok, err := a.findByName(ctx, subscription, *db.Name); err != nil || ok {
+		reservation.Release()
+		return id, err
+	}
+
+	var task internal.TaskResponse
+	err := a.client.Post(ctx, fmt.Sprintf("create database for subscription %d", subscription), fmt.Sprintf("/subscriptions/%d/databases", subscription), db, &task)
+	if err != nil {
+		// The request may have been processed even though its response was lost, in which case the name is taken.
+		// The name stays reserved until that's known, so that concurrent calls don't create the database again.
+		if id, ok, findErr := a.findByName(ctx, subscription, *db.Name); findErr == nil && ok {
+			reservation.Release()
+			return id, nil
+		}
+		reservation.Fail(err)
+		return 0, err
+	}
+	reservation.Start(*task.ID)
+
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for new database for subscription %d to finish being created", subscription), internal.SubscriptionID(subscription), internal.TaskID(task.ID))
+
+	id, err := reservation.Wait(ctx, a.taskWaiter.WaitForResourceId)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// findByName returns the identifier of the database of the subscription with the given name, if any.
+func (a *API) findByName(ctx context.Context, subscription int, name string) (int, bool, error) {
+	list := a.List(ctx, subscription)
+	for list.Next() {
+		if redis.StringValue(list.Value().Name) == name {
+			return redis.IntValue(list.Value().ID), true, nil
+		}
+	}
+	if err := list.Err(); err != nil {
+		return 0, false, err
+	}
+	return 0, false, nil
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
 )
 
 type CreateDatabase struct {
@@ -16,8 +17,8 @@ type CreateDatabase struct {
 	SupportOSSClusterAPI                *bool                        `json:"supportOSSClusterApi,omitempty"`
 	RespVersion                         *string                      `json:"respVersion,omitempty"`
 	UseExternalEndpointForOSSClusterAPI *bool                        `json:"useExternalEndpointForOSSClusterApi,omitempty"`
-	DataPersistence                     *string                      `json:"dataPersistence,omitempty"`
-	DataEvictionPolicy                  *string                      `json:"dataEvictionPolicy,omitempty"`
+	DataPersistence                     *DataPersistence             `json:"dataPersistence,omitempty"`
+	DataEvictionPolicy                  *EvictionPolicy              `json:"dataEvictionPolicy,omitempty"`
 	Replication                         *bool                        `json:"replication,omitempty"`
 	ThroughputMeasurement               *CreateThroughputMeasurement `json:"throughputMeasurement,omitempty"`
 	AverageItemSizeInBytes              *int                         `json:"averageItemSizeInBytes,omitempty"`
@@ -26,9 +27,9 @@ type CreateDatabase struct {
 	// Deprecated: Use RemoteBackup instead
 	PeriodicBackupPath      *string               `json:"periodicBackupPath,omitempty"`
 	SourceIP                []*string             `json:"sourceIp,omitempty"`
-	ClientSSLCertificate    *string               `json:"clientSslCertificate,omitempty"`
-	ClientTLSCertificates   *[]*string            `json:"clientTlsCertificates,omitempty"`
-	Password                *string               `json:"password,omitempty"`
+	ClientSSLCertificate    *string               `json:"clientSslCertificate,omitempty" secret:"true"`
+	ClientTLSCertificates   *[]*string            `json:"clientTlsCertificates,omitempty" secret:"true"`
+	Password                *string               `json:"password,omitempty" secret:"true"`
 	Alerts                  []*Alert              `json:"alerts,omitempty"`
 	Modules                 []*Module             `json:"modules,omitempty"`
 	EnableTls               *bool                 `json:"enableTls,omitempty"`
@@ -57,35 +58,35 @@ type Database struct {
 	Name     *string `json:"name,omitempty"`
 	Protocol *string `json:"protocol,omitempty"`
 	// For filtering out active-active entries, this property should not be present in the JSON response
-	ActiveActiveRedis       *bool       `json:"activeActiveRedis,omitempty"`
-	Provider                *string     `json:"provider,omitempty"`
-	Region                  *string     `json:"region,omitempty"`
-	Status                  *string     `json:"status,omitempty"`
-	MemoryLimitInGB         *float64    `json:"memoryLimitInGb,omitempty"`
-	DatasetSizeInGB         *float64    `json:"datasetSizeInGb,omitempty"`
-	MemoryUsedInMB          *float64    `json:"memoryUsedInMb,omitempty"`
-	SupportOSSClusterAPI    *bool       `json:"supportOSSClusterApi,omitempty"`
-	RespVersion             *string     `json:"respVersion,omitempty"`
-	DataPersistence         *string     `json:"dataPersistence,omitempty"`
-	Replication             *bool       `json:"replication,omitempty"`
-	DataEvictionPolicy      *string     `json:"dataEvictionPolicy,omitempty"`
-	ThroughputMeasurement   *Throughput `json:"throughputMeasurement,omitempty"`
-	ReplicaOf               *ReplicaOf  `json:"replicaOf,omitempty"`
-	Clustering              *Clustering `json:"clustering,omitempty"`
-	Security                *Security   `json:"security,omitempty"`
-	Modules                 []*Module   `json:"modules,omitempty"`
-	Alerts                  []*Alert    `json:"alerts,omitempty"`
-	ActivatedOn             *time.Time  `json:"activatedOn,omitempty"`
-	LastModified            *time.Time  `json:"lastModified,omitempty"`
-	MemoryStorage           *string     `json:"memoryStorage,omitempty"`
-	PrivateEndpoint         *string     `json:"privateEndpoint,omitempty"`
-	PublicEndpoint          *string     `json:"publicEndpoint,omitempty"`
-	RedisVersionCompliance  *string     `json:"redisVersionCompliance,omitempty"`
-	Backup                  *Backup     `json:"backup,omitempty"`
-	QueryPerformanceFactor  *string     `json:"queryPerformanceFactor,omitempty"`
-	RedisVersion            *string     `json:"redisVersion,omitempty"`
-	AutoMinorVersionUpgrade *bool       `json:"autoMinorVersionUpgrade,omitempty"`
-	RamPercentage           *int        `json:"ramPercentage,omitempty"`
+	ActiveActiveRedis       *bool            `json:"activeActiveRedis,omitempty"`
+	Provider                *string          `json:"provider,omitempty"`
+	Region                  *string          `json:"region,omitempty"`
+	Status                  *Status          `json:"status,omitempty"`
+	MemoryLimitInGB         *float64         `json:"memoryLimitInGb,omitempty"`
+	DatasetSizeInGB         *float64         `json:"datasetSizeInGb,omitempty"`
+	MemoryUsedInMB          *float64         `json:"memoryUsedInMb,omitempty"`
+	SupportOSSClusterAPI    *bool            `json:"supportOSSClusterApi,omitempty"`
+	RespVersion             *string          `json:"respVersion,omitempty"`
+	DataPersistence         *DataPersistence `json:"dataPersistence,omitempty"`
+	Replication             *bool            `json:"replication,omitempty"`
+	DataEvictionPolicy      *EvictionPolicy  `json:"dataEvictionPolicy,omitempty"`
+	ThroughputMeasurement   *Throughput      `json:"throughputMeasurement,omitempty"`
+	ReplicaOf               *ReplicaOf       `json:"replicaOf,omitempty"`
+	Clustering              *Clustering      `json:"clustering,omitempty"`
+	Security                *Security        `json:"security,omitempty"`
+	Modules                 []*Module        `json:"modules,omitempty"`
+	Alerts                  []*Alert         `json:"alerts,omitempty"`
+	ActivatedOn             *time.Time       `json:"activatedOn,omitempty"`
+	LastModified            *time.Time       `json:"lastModified,omitempty"`
+	MemoryStorage           *string          `json:"memoryStorage,omitempty"`
+	PrivateEndpoint         *string          `json:"privateEndpoint,omitempty"`
+	PublicEndpoint          *string          `json:"publicEndpoint,omitempty"`
+	RedisVersionCompliance  *string          `json:"redisVersionCompliance,omitempty"`
+	Backup                  *Backup          `json:"backup,omitempty"`
+	QueryPerformanceFactor  *string          `json:"queryPerformanceFactor,omitempty"`
+	RedisVersion            *string          `json:"redisVersion,omitempty"`
+	AutoMinorVersionUpgrade *bool            `json:"autoMinorVersionUpgrade,omitempty"`
+	RamPercentage           *int             `json:"ramPercentage,omitempty"`
 }
 
 func (o Database) String() string {
@@ -120,7 +121,7 @@ type Security struct {
 	SSLClientAuthentication *bool     `json:"sslClientAuthentication,omitempty"`
 	TLSClientAuthentication *bool     `json:"tlsClientAuthentication,omitempty"`
 	SourceIPs               []*string `json:"sourceIps,omitempty"`
-	Password                *string   `json:"password,omitempty"`
+	Password                *string   `json:"password,omitempty" secret:"true"`
 	EnableTls               *bool     `json:"enableTls,omitempty"`
 }
 
@@ -162,28 +163,26 @@ type UpdateDatabase struct {
 	SupportOSSClusterAPI                *bool                        `json:"supportOSSClusterApi,omitempty"`
 	RespVersion                         *string                      `json:"respVersion,omitempty"`
 	UseExternalEndpointForOSSClusterAPI *bool                        `json:"useExternalEndpointForOSSClusterApi,omitempty"`
-	DataEvictionPolicy                  *string                      `json:"dataEvictionPolicy,omitempty"`
+	DataEvictionPolicy                  *EvictionPolicy              `json:"dataEvictionPolicy,omitempty"`
 	Replication                         *bool                        `json:"replication,omitempty"`
 	ThroughputMeasurement               *UpdateThroughputMeasurement `json:"throughputMeasurement,omitempty"`
 	RegexRules                          []*string                    `json:"regexRules,omitempty"`
-	DataPersistence                     *string                      `json:"dataPersistence,omitempty"`
-	ReplicaOf                           []*string                    `json:"replicaOf"`
+	DataPersistence                     *DataPersistence             `json:"dataPersistence,omitempty"`
+	ReplicaOf                           redis.Optional[[]*string]    `json:"replicaOf,omitzero"`
 	PeriodicBackupPath                  *string                      `json:"periodicBackupPath,omitempty"`
 	SourceIP                            []*string                    `json:"sourceIp,omitempty"`
-	ClientSSLCertificate                *string                      `json:"clientSslCertificate,omitempty"`
-	// Using a pointer to allow empty slices to be serialised/sent
-	ClientTLSCertificates *[]*string `json:"clientTlsCertificates,omitempty"`
-	Password              *string    `json:"password,omitempty"`
-	// It's important to use a pointer here, because the terraform user may want to send an empty list.
-	// In that case, the developer must pass a (pointer to a) non-nil, zero-length slice
-	// If the developer really wants to omit this value, passing a nil slice value would work
-	Alerts                  *[]*Alert             `json:"alerts,omitempty"`
-	EnableTls               *bool                 `json:"enableTls,omitempty"`
-	RemoteBackup            *DatabaseBackupConfig `json:"remoteBackup,omitempty"`
-	EnableDefaultUser       *bool                 `json:"enableDefaultUser,omitempty"`
-	QueryPerformanceFactor  *string               `json:"queryPerformanceFactor,omitempty"`
-	AutoMinorVersionUpgrade *bool                 `json:"autoMinorVersionUpgrade,omitempty"`
-	RamPercentage           *int                  `json:"ramPercentage,omitempty"`
+	ClientSSLCertificate                *string                      `json:"clientSslCertificate,omitempty" secret:"true"`
+	// Set to an empty slice to remove all certificates
+	ClientTLSCertificates redis.Optional[[]*string] `json:"clientTlsCertificates,omitzero" secret:"true"`
+	Password              *string                   `json:"password,omitempty" secret:"true"`
+	// Set to an empty slice to remove all alerts
+	Alerts                  redis.Optional[[]*Alert] `json:"alerts,omitzero"`
+	EnableTls               *bool                    `json:"enableTls,omitempty"`
+	RemoteBackup            *DatabaseBackupConfig    `json:"remoteBackup,omitempty"`
+	EnableDefaultUser       *bool                    `json:"enableDefaultUser,omitempty"`
+	QueryPerformanceFactor  *string                  `json:"queryPerformanceFactor,omitempty"`
+	AutoMinorVersionUpgrade *bool                    `json:"autoMinorVersionUpgrade,omitempty"`
+	RamPercentage           *int                     `json:"ramPercentage,omitempty"`
 }
 
 func (o UpdateDatabase) String() string {
@@ -218,7 +217,7 @@ func (o Import) String() string {
 
 // DatabaseCertificate represents the TLS certificate information for a database
 type DatabaseCertificate struct {
-	PublicCertificatePEMString string `json:"publicCertificatePEMString"`
+	PublicCertificatePEMString string `json:"publicCertificatePEMString" secret:"true"`
 }
 
 func (o DatabaseCertificate) String() string {
@@ -252,34 +251,6 @@ func (f *NotFound) Error() string {
 }
 
 const (
-	// StatusActive is the active value of the `Status` field in `Database`
-	StatusActive = "active"
-	// StatusDraft is the draft value of the `Status` field in `Database`
-	StatusDraft = "draft"
-	// StatusPending is the pending value of the `Status` field in `Database`
-	StatusPending = "pending"
-	// StatusRCPChangePending is the RCP change pending value of the `Status` field in `Database`
-	StatusRCPChangePending = "rcp-change-pending"
-	// StatusRCPDraft is the RCP draft value of the `Status` field in `Database`
-	StatusRCPDraft = "rcp-draft"
-	// StatusRCPActiveChangeDraft is the RCP active change draft value of the `Status` field in `Database`
-	StatusRCPActiveChangeDraft = "rcp-active-change-draft"
-	// StatusActiveChangeDraft is the Active change draft value of the `Status` field in `Database`
-	StatusActiveChangeDraft = "active-change-draft"
-	// StatusActiveChangePending is the Active change pending value of the `Status` field in `Database`
-	StatusActiveChangePending = "active-change-pending"
-	// StatusDynamicEndpointsCreationPending is the Dynamic endpoints creation pending value of the `Status` field in `Database`
-	StatusDynamicEndpointsCreationPending = "dynamic-endpoints-creation-pending"
-	StatusActiveUpgradePending            = "active-upgrade-pending"
-	// StatusProxyPolicyChangePending and StatusProxyPolicyChangeDraft
-	// The below two Proxy Policy states are caused by a change to the 'support_oss_cluster_api' attribute
-	// StatusProxyPolicyChangePending is the Proxy Policy change pending value of the `Status` field in `Database`.
-	StatusProxyPolicyChangePending = "proxy-policy-change-pending"
-	// StatusProxyPolicyChangeDraft is the Proxy Policy change draft value of the `Status` field in `Database`
-	StatusProxyPolicyChangeDraft = "proxy-policy-change-draft"
-
-	// StatusError is the error value of the `Status` field in `Database`
-	StatusError = "error"
 	// BackupIntervalEvery24Hours is the schedule to back up once a day
 	BackupIntervalEvery24Hours = "every-24-hours"
 	// BackupIntervalEvery12Hours is the schedule to back up twice a day
@@ -314,25 +285,39 @@ func ProtocolValues() []string {
 
 func DataPersistenceValues() []string {
 	return []string{
-		"none",
-		"aof-every-1-second",
-		"aof-every-write",
-		"snapshot-every-1-hour",
-		"snapshot-every-6-hours",
-		"snapshot-every-12-hours",
+		string(DataPersistenceNone),
+		string(DataPersistenceAOFEvery1Second),
+		string(DataPersistenceAOFEveryWrite),
+		string(DataPersistenceSnapshotEvery1Hour),
+		string(DataPersistenceSnapshotEvery6Hours),
+		string(DataPersistenceSnapshotEvery12Hours),
 	}
 }
 
 func DataEvictionPolicyValues() []string {
 	return []string{
-		"allkeys-lru",
-		"allkeys-lfu",
-		"allkeys-random",
-		"volatile-lru",
-		"volatile-lfu",
-		"volatile-random",
-		"volatile-ttl",
-		"noeviction",
+		string(EvictionPolicyAllKeysLRU),
+		string(EvictionPolicyAllKeysLFU),
+		string(EvictionPolicyAllKeysRandom),
+		string(EvictionPolicyVolatileLRU),
+		string(EvictionPolicyVolatileLFU),
+		string(EvictionPolicyVolatileRandom),
+		string(EvictionPolicyVolatileTTL),
+		string(EvictionPolicyNoEviction),
+	}
+}
+
+func RespVersionValues() []string {
+	return []string{
+		"resp2",
+		"resp3",
+	}
+}
+
+func ThroughputMeasurementByValues() []string {
+	return []string{
+		"operations-per-second",
+		"number-of-shards",
 	}
 }
 
@@ -4,31 +4,32 @@ import (
 	"time"
 
 	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
 )
 
 type ActiveActiveDatabase struct {
-	ID                                  *int            `json:"databaseId,omitempty"`
-	Name                                *string         `json:"name,omitempty"`
-	Protocol                            *string         `json:"protocol,omitempty"`
-	Status                              *string         `json:"status,omitempty"`
-	RedisVersion                        *string         `json:"redisVersion,omitempty"`
-	MemoryStorage                       *string         `json:"memoryStorage,omitempty"`
-	ActiveActiveRedis                   *bool           `json:"activeActiveRedis,omitempty"`
-	ActivatedOn                         *time.Time      `json:"activatedOn,omitempty"`
-	LastModified                        *time.Time      `json:"lastModified,omitempty"`
-	SupportOSSClusterAPI                *bool           `json:"supportOSSClusterApi,omitempty"`
-	UseExternalEndpointForOSSClusterAPI *bool           `json:"useExternalEndpointForOSSClusterApi,omitempty"`
-	Replication                         *bool           `json:"replication,omitempty"`
-	DataEvictionPolicy                  *string         `json:"dataEvictionPolicy,omitempty"`
-	Security                            *Security       `json:"security,omitempty"`
-	Modules                             []*Module       `json:"modules,omitempty"`
-	GlobalDataPersistence               *string         `json:"globalDataPersistence,omitempty"`
-	GlobalSourceIP                      []*string       `json:"globalSourceIp,omitempty"`
-	GlobalPassword                      *string         `json:"globalPassword,omitempty"`
-	GlobalAlerts                        []*Alert        `json:"globalAlerts,omitempty"`
-	GlobalEnableDefaultUser             *bool           `json:"globalEnableDefaultUser,omitempty"`
-	CrdbDatabases                       []*CrdbDatabase `json:"crdbDatabases,omitempty"`
-	AutoMinorVersionUpgrade             *bool           `json:"autoMinorVersionUpgrade,omitempty"`
+	ID                                  *int             `json:"databaseId,omitempty"`
+	Name                                *string          `json:"name,omitempty"`
+	Protocol                            *string          `json:"protocol,omitempty"`
+	Status                              *Status          `json:"status,omitempty"`
+	RedisVersion                        *string          `json:"redisVersion,omitempty"`
+	MemoryStorage                       *string          `json:"memoryStorage,omitempty"`
+	ActiveActiveRedis                   *bool            `json:"activeActiveRedis,omitempty"`
+	ActivatedOn                         *time.Time       `json:"activatedOn,omitempty"`
+	LastModified                        *time.Time       `json:"lastModified,omitempty"`
+	SupportOSSClusterAPI                *bool            `json:"supportOSSClusterApi,omitempty"`
+	UseExternalEndpointForOSSClusterAPI *bool            `json:"useExternalEndpointForOSSClusterApi,omitempty"`
+	Replication                         *bool            `json:"replication,omitempty"`
+	DataEvictionPolicy                  *EvictionPolicy  `json:"dataEvictionPolicy,omitempty"`
+	Security                            *Security        `json:"security,omitempty"`
+	Modules                             []*Module        `json:"modules,omitempty"`
+	GlobalDataPersistence               *DataPersistence `json:"globalDataPersistence,omitempty"`
+	GlobalSourceIP                      []*string        `json:"globalSourceIp,omitempty"`
+	GlobalPassword                      *string          `json:"globalPassword,omitempty" secret:"true"`
+	GlobalAlerts                        []*Alert         `json:"globalAlerts,omitempty"`
+	GlobalEnableDefaultUser             *bool            `json:"globalEnableDefaultUser,omitempty"`
+	CrdbDatabases                       []*CrdbDatabase  `json:"crdbDatabases,omitempty"`
+	AutoMinorVersionUpgrade             *bool            `json:"autoMinorVersionUpgrade,omitempty"`
 }
 
 func (o ActiveActiveDatabase) String() string {
@@ -36,21 +37,21 @@ func (o ActiveActiveDatabase) String() string {
 }
 
 type CrdbDatabase struct {
-	Provider                 *string   `json:"provider,omitempty"`
-	Region                   *string   `json:"region,omitempty"`
-	RedisVersionCompliance   *string   `json:"redisVersionCompliance,omitempty"`
-	PublicEndpoint           *string   `json:"publicEndpoint,omitempty"`
-	PrivateEndpoint          *string   `json:"privateEndpoint,omitempty"`
-	MemoryLimitInGB          *float64  `json:"memoryLimitInGb,omitempty"`
-	DatasetSizeInGB          *float64  `json:"datasetSizeInGb,omitempty"`
-	MemoryUsedInMB           *float64  `json:"memoryUsedInMb,omitempty"`
-	ReadOperationsPerSecond  *int      `json:"readOperationsPerSecond,omitempty"`
-	WriteOperationsPerSecond *int      `json:"writeOperationsPerSecond,omitempty"`
-	DataPersistence          *string   `json:"dataPersistence,omitempty"`
-	Alerts                   []*Alert  `json:"alerts,omitempty"`
-	Security                 *Security `json:"security,omitempty"`
-	Backup                   *Backup   `json:"backup,omitempty"`
-	QueryPerformanceFactor   *string   `json:"queryPerformanceFactor,omitempty"`
+	Provider                 *string          `json:"provider,omitempty"`
+	Region                   *string          `json:"region,omitempty"`
+	RedisVersionCompliance   *string          `json:"redisVersionCompliance,omitempty"`
+	PublicEndpoint           *string          `json:"publicEndpoint,omitempty"`
+	PrivateEndpoint          *string          `json:"privateEndpoint,omitempty"`
+	MemoryLimitInGB          *float64         `json:"memoryLimitInGb,omitempty"`
+	DatasetSizeInGB          *float64         `json:"datasetSizeInGb,omitempty"`
+	MemoryUsedInMB           *float64         `json:"memoryUsedInMb,omitempty"`
+	ReadOperationsPerSecond  *int             `json:"readOperationsPerSecond,omitempty"`
+	WriteOperationsPerSecond *int             `json:"writeOperationsPerSecond,omitempty"`
+	DataPersistence          *DataPersistence `json:"dataPersistence,omitempty"`
+	Alerts                   []*Alert         `json:"alerts,omitempty"`
+	Security                 *Security        `json:"security,omitempty"`
+	Backup                   *Backup          `json:"backup,omitempty"`
+	QueryPerformanceFactor   *string          `json:"queryPerformanceFactor,omitempty"`
 }
 
 func (o CrdbDatabase) String() string {
@@ -77,10 +78,10 @@ type CreateActiveActiveDatabase struct {
 	SupportOSSClusterAPI                *bool              `json:"supportOSSClusterApi,omitempty"`
 	RespVersion                         *string            `json:"respVersion,omitempty"`
 	UseExternalEndpointForOSSClusterAPI *bool              `json:"useExternalEndpointForOSSClusterApi,omitempty"`
-	DataEvictionPolicy                  *string            `json:"dataEvictionPolicy,omitempty"`
-	GlobalDataPersistence               *string            `json:"dataPersistence,omitempty"`
+	DataEvictionPolicy                  *EvictionPolicy    `json:"dataEvictionPolicy,omitempty"`
+	GlobalDataPersistence               *DataPersistence   `json:"dataPersistence,omitempty"`
 	GlobalSourceIP                      []*string          `json:"sourceIp,omitempty"`
-	GlobalPassword                      *string            `json:"password,omitempty"`
+	GlobalPassword                      *string            `json:"password,omitempty" secret:"true"`
 	GlobalAlerts                        []*Alert           `json:"alerts,omitempty"`
 	GlobalModules                       []*Module          `json:"modules,omitempty"`
 	LocalThroughputMeasurement          []*LocalThroughput `json:"localThroughputMeasurement,omitempty"`
@@ -104,26 +105,25 @@ func (o LocalThroughput) String() string {
 	return internal.ToString(o)
 }
 
-// Uses pointers for slices to allow empty slices to be serialised/sent
 type UpdateActiveActiveDatabase struct {
-	DryRun                              *bool                    `json:"dryRun,omitempty"`
-	Name                                *string                  `json:"name,omitempty"`
-	MemoryLimitInGB                     *float64                 `json:"memoryLimitInGb,omitempty"`
-	DatasetSizeInGB                     *float64                 `json:"datasetSizeInGb,omitempty"`
-	SupportOSSClusterAPI                *bool                    `json:"supportOSSClusterApi,omitempty"`
-	UseExternalEndpointForOSSClusterAPI *bool                    `json:"useExternalEndpointForOSSClusterApi,omitempty"`
-	ClientSSLCertificate                *string                  `json:"clientSslCertificate,omitempty"`
-	ClientTLSCertificates               *[]*string               `json:"clientTlsCertificates,omitempty"`
-	EnableTls                           *bool                    `json:"enableTls,omitempty"`
-	GlobalDataPersistence               *string                  `json:"globalDataPersistence,omitempty"`
-	GlobalPassword                      *string                  `json:"globalPassword,omitempty"`
-	GlobalEnableDefaultUser             *bool                    `json:"globalEnableDefaultUser,omitempty"`
-	GlobalSourceIP                      []*string                `json:"globalSourceIp,omitempty"`
-	GlobalAlerts                        *[]*Alert                `json:"globalAlerts,omitempty"`
-	Regions                             []*LocalRegionProperties `json:"regions,omitempty"`
-	DataEvictionPolicy                  *string                  `json:"dataEvictionPolicy,omitempty"`
-	QueryPerformanceFactor              *string                  `json:"queryPerformanceFactor,omitempty"`
-	AutoMinorVersionUpgrade             *bool                    `json:"autoMinorVersionUpgrade,omitempty"`
+	DryRun                              *bool                     `json:"dryRun,omitempty"`
+	Name                                *string                   `json:"name,omitempty"`
+	MemoryLimitInGB                     *float64                  `json:"memoryLimitInGb,omitempty"`
+	DatasetSizeInGB                     *float64                  `json:"datasetSizeInGb,omitempty"`
+	SupportOSSClusterAPI                *bool                     `json:"supportOSSClusterApi,omitempty"`
+	UseExternalEndpointForOSSClusterAPI *bool                     `json:"useExternalEndpointForOSSClusterApi,omitempty"`
+	ClientSSLCertificate                *string                   `json:"clientSslCertificate,omitempty" secret:"true"`
+	ClientTLSCertificates               redis.Optional[[]*string] `json:"clientTlsCertificates,omitzero" secret:"true"`
+	EnableTls                           *bool                     `json:"enableTls,omitempty"`
+	GlobalDataPersistence               *DataPersistence          `json:"globalDataPersistence,omitempty"`
+	GlobalPassword                      *string                   `json:"globalPassword,omitempty" secret:"true"`
+	GlobalEnableDefaultUser             *bool                     `json:"globalEnableDefaultUser,omitempty"`
+	GlobalSourceIP                      []*string                 `json:"globalSourceIp,omitempty"`
+	GlobalAlerts                        redis.Optional[[]*Alert]  `json:"globalAlerts,omitzero"`
+	Regions                             []*LocalRegionProperties  `json:"regions,omitempty"`
+	DataEvictionPolicy                  *EvictionPolicy           `json:"dataEvictionPolicy,omitempty"`
+	QueryPerformanceFactor              *string                   `json:"queryPerformanceFactor,omitempty"`
+	AutoMinorVersionUpgrade             *bool                     `json:"autoMinorVersionUpgrade,omitempty"`
 }
 
 func (o UpdateActiveActiveDatabase) String() string {
@@ -134,12 +134,12 @@ type LocalRegionProperties struct {
 	Region                     *string               `json:"region,omitempty"`
 	RemoteBackup               *DatabaseBackupConfig `json:"remoteBackup,omitempty"`
 	LocalThroughputMeasurement *LocalThroughput      `json:"localThroughputMeasurement,omitempty"`
-	DataPersistence            *string               `json:"dataPersistence,omitempty"`
-	Password                   *string               `json:"password,omitempty"`
+	DataPersistence            *DataPersistence      `json:"dataPersistence,omitempty"`
+	Password                   *string               `json:"password,omitempty" secret:"true"`
 	SourceIP                   []*string             `json:"sourceIp,omitempty"`
 	EnableDefaultUser          *bool                 `json:"enableDefaultUser,omitempty"`
-	// Using a pointer to allow empty slices to be serialised/sent
-	Alerts *[]*Alert `json:"alerts,omitempty"`
+	// Set to an empty slice to remove all alerts
+	Alerts redis.Optional[[]*Alert] `json:"alerts,omitzero"`
 }
 
 func (o LocalRegionProperties) String() string {
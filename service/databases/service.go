@@ -26,6 +26,7 @@ type HttpClient interface {
 
 type TaskWaiter interface {
 	WaitForResourceId(ctx context.Context, id string) (int, error)
+	WaitForResource(ctx context.Context, id string, resource interface{}) error
 	Wait(ctx context.Context, id string) error
 }
 
@@ -33,6 +34,7 @@ type API struct {
 	client     HttpClient
 	taskWaiter TaskWaiter
 	logger     Log
+	inFlight   internal.InFlight
 }
 
 func NewAPI(client HttpClient, taskWaiter TaskWaiter, logger Log) *API {
@@ -47,7 +49,7 @@ func (a *API) Create(ctx context.Context, subscription int, db CreateDatabase) (
 		return 0, err
 	}
 
-	a.logger.Printf("Waiting for new database for subscription %d to finish being created", subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for new database for subscription %d to finish being created", subscription), internal.SubscriptionID(subscription))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -82,7 +84,7 @@ func (a *API) Update(ctx context.Context, subscription int, database int, update
 		return err
 	}
 
-	a.logger.Printf("Waiting for database %d for subscription %d to finish being updated", database, subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for database %d for subscription %d to finish being updated", database, subscription), internal.DatabaseID(database), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -95,7 +97,7 @@ func (a *API) UpgradeRedisVersion(ctx context.Context, subscription int, databas
 		return err
 	}
 
-	a.logger.Printf("Waiting for database %d for subscription %d to finish being upgraded", database, subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for database %d for subscription %d to finish being upgraded", database, subscription), internal.DatabaseID(database), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -108,7 +110,7 @@ func (a *API) Delete(ctx context.Context, subscription int, database int) error
 		return err
 	}
 
-	a.logger.Printf("Waiting for database %d for subscription %d to finish being deleted", database, subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for database %d for subscription %d to finish being deleted", database, subscription), internal.DatabaseID(database), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -121,7 +123,7 @@ func (a *API) Backup(ctx context.Context, subscription int, database int) error
 		return err
 	}
 
-	a.logger.Printf("Waiting for backup of database %d for subscription %d to finish", database, subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for backup of database %d for subscription %d to finish", database, subscription), internal.DatabaseID(database), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -134,7 +136,7 @@ func (a *API) Import(ctx context.Context, subscription int, database int, reques
 		return err
 	}
 
-	a.logger.Printf("Waiting for import into database %d for subscription %d to finish", database, subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for import into database %d for subscription %d to finish", database, subscription), internal.DatabaseID(database), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -19,7 +19,7 @@ func (a *API) ActiveActiveCreate(ctx context.Context, subscription int, db Creat
 		return 0, err
 	}
 
-	a.logger.Printf("Waiting for new database for subscription %d to finish being created", subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for new database for subscription %d to finish being created", subscription), internal.SubscriptionID(subscription))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -37,7 +37,7 @@ func (a *API) ActiveActiveUpdate(ctx context.Context, subscription int, database
 		return err
 	}
 
-	a.logger.Printf("Waiting for database %d for subscription %d to finish being updated", database, subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for database %d for subscription %d to finish being updated", database, subscription), internal.DatabaseID(database), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -0,0 +1,113 @@
+package databases
+
+import (
+	"fmt"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+)
+
+// Custom ports have to come from the range reserved for databases
+const (
+	minPortNumber = 10000
+	maxPortNumber = 19999
+)
+
+// Validate checks the request for problems that the API would otherwise reject, without contacting the API.
+func (o CreateDatabase) Validate() error {
+	v := internal.NewValidation("create database request")
+	v.Required("name", o.Name != nil)
+	internal.OneOf(v, "protocol", o.Protocol, ProtocolValues())
+	internal.OneOf(v, "respVersion", o.RespVersion, RespVersionValues())
+	internal.OneOf(v, "dataPersistence", o.DataPersistence, DataPersistenceValues())
+	internal.OneOf(v, "dataEvictionPolicy", o.DataEvictionPolicy, DataEvictionPolicyValues())
+	v.Exclusive(map[string]bool{"memoryLimitInGb": o.MemoryLimitInGB != nil, "datasetSizeInGb": o.DatasetSizeInGB != nil})
+	internal.Positive(v, "memoryLimitInGb", o.MemoryLimitInGB)
+	internal.Positive(v, "datasetSizeInGb", o.DatasetSizeInGB)
+	internal.Positive(v, "averageItemSizeInBytes", o.AverageItemSizeInBytes)
+	internal.InRange(v, "ramPercentage", o.RamPercentage, 0, 100)
+	internal.InRange(v, "port", o.PortNumber, minPortNumber, maxPortNumber)
+	if o.ThroughputMeasurement != nil {
+		validateThroughput(v, o.ThroughputMeasurement.By, o.ThroughputMeasurement.Value)
+	}
+	v.SourceIPs("sourceIp", o.SourceIP)
+	v.Exclusive(map[string]bool{"clientSslCertificate": o.ClientSSLCertificate != nil, "clientTlsCertificates": o.ClientTLSCertificates != nil})
+	v.Exclusive(map[string]bool{"periodicBackupPath": o.PeriodicBackupPath != nil, "remoteBackup": o.RemoteBackup != nil})
+	validateAlerts(v, "alerts", o.Alerts)
+	validateBackup(v, "remoteBackup", o.RemoteBackup)
+	return v.Err()
+}
+
+// Validate checks the request for problems that the API would otherwise reject, without contacting the API.
+func (o UpdateDatabase) Validate() error {
+	v := internal.NewValidation("update database request")
+	internal.OneOf(v, "respVersion", o.RespVersion, RespVersionValues())
+	internal.OneOf(v, "dataPersistence", o.DataPersistence, DataPersistenceValues())
+	internal.OneOf(v, "dataEvictionPolicy", o.DataEvictionPolicy, DataEvictionPolicyValues())
+	v.Exclusive(map[string]bool{"memoryLimitInGb": o.MemoryLimitInGB != nil, "datasetSizeInGb": o.DatasetSizeInGB != nil})
+	internal.Positive(v, "memoryLimitInGb", o.MemoryLimitInGB)
+	internal.Positive(v, "datasetSizeInGb", o.DatasetSizeInGB)
+	internal.InRange(v, "ramPercentage", o.RamPercentage, 0, 100)
+	if o.ThroughputMeasurement != nil {
+		validateThroughput(v, o.ThroughputMeasurement.By, o.ThroughputMeasurement.Value)
+	}
+	v.SourceIPs("sourceIp", o.SourceIP)
+	v.Exclusive(map[string]bool{"clientSslCertificate": o.ClientSSLCertificate != nil, "clientTlsCertificates": o.ClientTLSCertificates.Ptr() != nil})
+	v.Exclusive(map[string]bool{"periodicBackupPath": o.PeriodicBackupPath != nil, "remoteBackup": o.RemoteBackup != nil})
+	if alerts, ok := o.Alerts.Get(); ok {
+		validateAlerts(v, "alerts", alerts)
+	}
+	validateBackup(v, "remoteBackup", o.RemoteBackup)
+	return v.Err()
+}
+
+// Validate checks the request for problems that the API would otherwise reject, without contacting the API.
+func (o CreateActiveActiveDatabase) Validate() error {
+	v := internal.NewValidation("create Active-Active database request")
+	v.Required("name", o.Name != nil)
+	internal.OneOf(v, "protocol", o.Protocol, ProtocolValues())
+	internal.OneOf(v, "respVersion", o.RespVersion, RespVersionValues())
+	internal.OneOf(v, "dataPersistence", o.GlobalDataPersistence, DataPersistenceValues())
+	internal.OneOf(v, "dataEvictionPolicy", o.DataEvictionPolicy, DataEvictionPolicyValues())
+	v.Exclusive(map[string]bool{"memoryLimitInGb": o.MemoryLimitInGB != nil, "datasetSizeInGb": o.DatasetSizeInGB != nil})
+	internal.Positive(v, "memoryLimitInGb", o.MemoryLimitInGB)
+	internal.Positive(v, "datasetSizeInGb", o.DatasetSizeInGB)
+	internal.InRange(v, "port", o.PortNumber, minPortNumber, maxPortNumber)
+	v.SourceIPs("sourceIp", o.GlobalSourceIP)
+	validateAlerts(v, "alerts", o.GlobalAlerts)
+	for i, throughput := range o.LocalThroughputMeasurement {
+		if throughput == nil {
+			continue
+		}
+		field := fmt.Sprintf("localThroughputMeasurement[%d]", i)
+		v.Required(field+".region", throughput.Region != nil)
+		internal.Positive(v, field+".writeOperationsPerSecond", throughput.WriteOperationsPerSecond)
+		internal.Positive(v, field+".readOperationsPerSecond", throughput.ReadOperationsPerSecond)
+	}
+	return v.Err()
+}
+
+func validateThroughput(v *internal.Validation, by *string, value *int) {
+	v.Required("throughputMeasurement.by", by != nil)
+	internal.OneOf(v, "throughputMeasurement.by", by, ThroughputMeasurementByValues())
+	v.Required("throughputMeasurement.value", value != nil)
+	internal.Positive(v, "throughputMeasurement.value", value)
+}
+
+func validateAlerts(v *internal.Validation, field string, alerts []*Alert) {
+	for i, alert := range alerts {
+		if alert == nil {
+			continue
+		}
+		v.Required(fmt.Sprintf("%s[%d].name", field, i), alert.Name != nil)
+		internal.OneOf(v, fmt.Sprintf("%s[%d].name", field, i), alert.Name, AlertNameValues())
+		v.Required(fmt.Sprintf("%s[%d].value", field, i), alert.Value != nil)
+	}
+}
+
+func validateBackup(v *internal.Validation, field string, backup *DatabaseBackupConfig) {
+	if backup == nil {
+		return
+	}
+	internal.OneOf(v, field+".interval", backup.Interval, BackupIntervals())
+	internal.OneOf(v, field+".storageType", backup.StorageType, BackupStorageTypes())
+}
@@ -0,0 +1,163 @@
+package databases
+
+import (
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+)
+
+// ToCreate converts the fixed database into a CreateFixedDatabase request with the same configuration, e.g. to
+// clone it.
+//
+// Some values are never returned by the API, so the second return value lists the JSON names of the fields the
+// caller must supply themselves before the request can recreate the database faithfully.
+func (o FixedDatabase) ToCreate() (CreateFixedDatabase, []string) {
+	var missing []string
+	security, clustering := o.security(), o.clustering()
+
+	create := CreateFixedDatabase{
+		Name:                                o.Name,
+		Protocol:                            o.Protocol,
+		MemoryLimitInGB:                     o.MemoryLimitInGb,
+		DatasetSizeInGB:                     o.DatasetSizeInGB,
+		SupportOSSClusterAPI:                o.SupportOSSClusterAPI,
+		RespVersion:                         o.RespVersion,
+		UseExternalEndpointForOSSClusterAPI: o.UseExternalEndpointForOSSClusterAPI,
+		EnableDatabaseClustering:            clustering.Enabled,
+		DataPersistence:                     o.DataPersistence,
+		DataEvictionPolicy:                  o.DataEvictionPolicy,
+		Replication:                         o.Replication,
+		PeriodicBackupPath:                  o.backupPath(),
+		SourceIPs:                           copyStrings(security.SourceIPs),
+		RegexRules:                          regexPatterns(clustering.RegexRules),
+		Replica:                             copyReplica(o.Replica),
+		EnableTls:                           security.EnableTls,
+		Password:                            security.Password,
+		Alerts:                              copyAlerts(o.Alerts),
+		Modules:                             copyModules(o.Modules),
+		RedisVersion:                        o.RedisVersion,
+	}
+
+	if security.Password == nil && redis.StringValue(o.Protocol) != "memcached" {
+		missing = append(missing, "password")
+	}
+	if redis.BoolValue(security.SSLClientAuthentication) || redis.BoolValue(security.TLSClientAuthentication) {
+		missing = append(missing, "clientTlsCertificates")
+	}
+
+	return create, missing
+}
+
+// ToUpdate converts the fixed database into an UpdateFixedDatabase request that re-applies its current
+// configuration.
+//
+// Some values are never returned by the API, so the second return value lists the JSON names of the fields the
+// caller must supply themselves before the request can re-apply the configuration faithfully.
+func (o FixedDatabase) ToUpdate() (UpdateFixedDatabase, []string) {
+	var missing []string
+	security, clustering := o.security(), o.clustering()
+
+	update := UpdateFixedDatabase{
+		Name:                                o.Name,
+		MemoryLimitInGB:                     o.MemoryLimitInGb,
+		DatasetSizeInGB:                     o.DatasetSizeInGB,
+		SupportOSSClusterAPI:                o.SupportOSSClusterAPI,
+		RespVersion:                         o.RespVersion,
+		UseExternalEndpointForOSSClusterAPI: o.UseExternalEndpointForOSSClusterAPI,
+		EnableDatabaseClustering:            clustering.Enabled,
+		DataPersistence:                     o.DataPersistence,
+		DataEvictionPolicy:                  o.DataEvictionPolicy,
+		Replication:                         o.Replication,
+		PeriodicBackupPath:                  o.backupPath(),
+		SourceIPs:                           copyStrings(security.SourceIPs),
+		Replica:                             copyReplica(o.Replica),
+		RegexRules:                          regexPatterns(clustering.RegexRules),
+		EnableTls:                           security.EnableTls,
+		Password:                            security.Password,
+		Alerts:                              redis.FromPtr(copyAlerts(o.Alerts)),
+		EnableDefaultUser:                   security.EnableDefaultUser,
+	}
+
+	if redis.BoolValue(security.SSLClientAuthentication) || redis.BoolValue(security.TLSClientAuthentication) {
+		missing = append(missing, "clientTlsCertificates")
+	}
+
+	return update, missing
+}
+
+func (o FixedDatabase) security() *Security {
+	if o.Security == nil {
+		return &Security{}
+	}
+	return o.Security
+}
+
+func (o FixedDatabase) clustering() *Clustering {
+	if o.Clustering == nil {
+		return &Clustering{}
+	}
+	return o.Clustering
+}
+
+func (o FixedDatabase) backupPath() *string {
+	if o.Backup == nil || !redis.BoolValue(o.Backup.Enabled) {
+		return nil
+	}
+	return o.Backup.Destination
+}
+
+func regexPatterns(rules []*databases.RegexRule) []*string {
+	var ret []*string
+	for _, rule := range rules {
+		if rule != nil {
+			ret = append(ret, redis.String(rule.Pattern))
+		}
+	}
+	return ret
+}
+
+func copyReplica(replica *ReplicaOf) *ReplicaOf {
+	if replica == nil {
+		return nil
+	}
+	ret := &ReplicaOf{Description: replica.Description}
+	for _, source := range replica.SyncSources {
+		if source != nil {
+			copied := *source
+			ret.SyncSources = append(ret.SyncSources, &copied)
+		}
+	}
+	return ret
+}
+
+func copyStrings(ss []*string) []*string {
+	if ss == nil {
+		return nil
+	}
+	return redis.StringSlice(redis.StringSliceValue(ss...)...)
+}
+
+func copyAlerts(alerts *[]*databases.Alert) *[]*databases.Alert {
+	if alerts == nil {
+		return nil
+	}
+	ret := make([]*databases.Alert, 0, len(*alerts))
+	for _, alert := range *alerts {
+		if alert != nil {
+			ret = append(ret, &databases.Alert{Name: alert.Name, Value: alert.Value})
+		}
+	}
+	return &ret
+}
+
+func copyModules(modules *[]*databases.Module) *[]*databases.Module {
+	if modules == nil {
+		return nil
+	}
+	ret := make([]*databases.Module, 0, len(*modules))
+	for _, module := range *modules {
+		if module != nil {
+			ret = append(ret, &databases.Module{Name: module.Name})
+		}
+	}
+	return &ret
+}
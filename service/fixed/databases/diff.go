@@ -0,0 +1,84 @@
+package databases
+
+import (
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+)
+
+// Diff compares a live fixed database against the desired configuration, expressed as an UpdateFixedDatabase, and
+// returns the minimal UpdateFixedDatabase needed to reach it along with a description of every change. Fields left
+// nil in desired are treated as "don't care" and never appear in the result.
+func Diff(live *FixedDatabase, desired UpdateFixedDatabase) (UpdateFixedDatabase, databases.Changes) {
+	d := &internal.Differ{}
+	if live == nil {
+		live = &FixedDatabase{}
+	}
+	security := live.Security
+	if security == nil {
+		security = &Security{}
+	}
+	clustering := live.Clustering
+	if clustering == nil {
+		clustering = &Clustering{}
+	}
+
+	update := UpdateFixedDatabase{
+		Name:                                internal.DiffValue(d, "name", live.Name, desired.Name),
+		MemoryLimitInGB:                     internal.DiffValue(d, "memoryLimitInGb", live.MemoryLimitInGb, desired.MemoryLimitInGB),
+		DatasetSizeInGB:                     internal.DiffValue(d, "datasetSizeInGb", live.DatasetSizeInGB, desired.DatasetSizeInGB),
+		SupportOSSClusterAPI:                internal.DiffValue(d, "supportOSSClusterApi", live.SupportOSSClusterAPI, desired.SupportOSSClusterAPI),
+		RespVersion:                         internal.DiffValue(d, "respVersion", live.RespVersion, desired.RespVersion),
+		UseExternalEndpointForOSSClusterAPI: internal.DiffValue(d, "useExternalEndpointForOSSClusterApi", live.UseExternalEndpointForOSSClusterAPI, desired.UseExternalEndpointForOSSClusterAPI),
+		EnableDatabaseClustering:            internal.DiffValue(d, "enableDatabaseClustering", clustering.Enabled, desired.EnableDatabaseClustering),
+		DataPersistence:                     internal.DiffValue(d, "dataPersistence", live.DataPersistence, desired.DataPersistence),
+		DataEvictionPolicy:                  internal.DiffValue(d, "dataEvictionPolicy", live.DataEvictionPolicy, desired.DataEvictionPolicy),
+		Replication:                         internal.DiffValue(d, "replication", live.Replication, desired.Replication),
+		PeriodicBackupPath:                  internal.DiffValue(d, "periodicBackupPath", backupDestination(live.Backup), desired.PeriodicBackupPath),
+		SourceIPs:                           internal.DiffStrings(d, "sourceIps", security.SourceIPs, desired.SourceIPs),
+		Replica:                             diffReplica(d, live.Replica, desired.Replica),
+		RegexRules:                          diffRegexRules(d, clustering.RegexRules, desired.RegexRules),
+		ClientTlsCertificates:               diffCertificates(d, desired.ClientTlsCertificates),
+		EnableTls:                           internal.DiffValue(d, "enableTls", security.EnableTls, desired.EnableTls),
+		Password:                            internal.DiffSecret(d, "password", security.Password, desired.Password),
+		Alerts:                              internal.DiffOptional(d, "alerts", live.Alerts, desired.Alerts),
+		EnableDefaultUser:                   internal.DiffValue(d, "enableDefaultUser", security.EnableDefaultUser, desired.EnableDefaultUser),
+	}
+
+	return update, databases.NewChanges(d)
+}
+
+func diffReplica(d *internal.Differ, live *ReplicaOf, desired *ReplicaOf) *ReplicaOf {
+	return internal.DiffNested(d, "replica", live, desired)
+}
+
+func diffRegexRules(d *internal.Differ, live []*databases.RegexRule, desired []*string) []*string {
+	if desired == nil {
+		return nil
+	}
+	patterns := make([]*string, 0, len(live))
+	for _, rule := range live {
+		if rule != nil {
+			patterns = append(patterns, redis.String(rule.Pattern))
+		}
+	}
+	// Ordering matters for regex rules, so they are compared as a list rather than a set
+	if changed := internal.DiffDeep(d, "regexRules", &patterns, &desired); changed != nil {
+		return *changed
+	}
+	return nil
+}
+
+func diffCertificates(d *internal.Differ, desired []*DatabaseCertificate) []*DatabaseCertificate {
+	if desired == nil {
+		return nil
+	}
+	return *internal.DiffWriteOnly(d, "clientTlsCertificates", &desired, false)
+}
+
+func backupDestination(backup *Backup) *string {
+	if backup == nil {
+		return nil
+	}
+	return backup.Destination
+}
@@ -0,0 +1,40 @@
+package databases
+
+import (
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff_mapsReadModelFields(t *testing.T) {
+	live := &FixedDatabase{
+		Name: redis.String("example"),
+		Security: &Security{
+			EnableDefaultUser: redis.Bool(true),
+			SourceIPs:         redis.StringSlice("10.0.0.0/24"),
+		},
+		Clustering: &Clustering{
+			Enabled:    redis.Bool(true),
+			RegexRules: []*databases.RegexRule{{Ordinal: 0, Pattern: ".*"}},
+		},
+		Alerts: &[]*databases.Alert{{Name: redis.String("dataset-size"), Value: redis.Int(80)}},
+	}
+
+	update, changes := Diff(live, UpdateFixedDatabase{
+		Name:                     redis.String("example"),
+		EnableDefaultUser:        redis.Bool(false),
+		SourceIPs:                redis.StringSlice("10.0.0.0/24"),
+		EnableDatabaseClustering: redis.Bool(true),
+		RegexRules:               redis.StringSlice(".*"),
+		Alerts:                   redis.Set([]*databases.Alert{}),
+	})
+
+	assert.Equal(t, UpdateFixedDatabase{
+		EnableDefaultUser: redis.Bool(false),
+		Alerts:            redis.Set([]*databases.Alert{}),
+	}, update)
+	assert.Equal(t, `alerts: [map[name:dataset-size value:80]] -> []
+enableDefaultUser: true -> false`, changes.String())
+}
@@ -5,82 +5,83 @@ import (
 	"time"
 
 	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
 	"github.com/RedisLabs/rediscloud-go-api/service/databases"
 )
 
 type CreateFixedDatabase struct {
-	Name                                *string                `json:"name,omitempty"`
-	Protocol                            *string                `json:"protocol,omitempty"`
-	MemoryLimitInGB                     *float64               `json:"memoryLimitInGb,omitempty"`
-	DatasetSizeInGB                     *float64               `json:"datasetSizeInGb,omitempty"`
-	SupportOSSClusterAPI                *bool                  `json:"supportOSSClusterApi,omitempty"`
-	RespVersion                         *string                `json:"respVersion,omitempty"`
-	UseExternalEndpointForOSSClusterAPI *bool                  `json:"useExternalEndpointForOSSClusterApi,omitempty"`
-	EnableDatabaseClustering            *bool                  `json:"enableDatabaseClustering,omitempty"`
-	DataPersistence                     *string                `json:"dataPersistence,omitempty"`
-	DataEvictionPolicy                  *string                `json:"dataEvictionPolicy,omitempty"`
-	Replication                         *bool                  `json:"replication,omitempty"`
-	PeriodicBackupPath                  *string                `json:"periodicBackupPath,omitempty"`
-	SourceIPs                           []*string              `json:"sourceIps,omitempty"`
-	RegexRules                          []*string              `json:"regexRules,omitempty"`
-	Replica                             *ReplicaOf             `json:"replica,omitempty"`
-	ClientTlsCertificates               []*DatabaseCertificate `json:"clientTlsCertificates,omitempty"`
-	EnableTls                           *bool                  `json:"enableTls,omitempty"`
-	Password                            *string                `json:"password,omitempty"`
-	Alerts                              *[]*databases.Alert    `json:"alerts,omitempty"`
-	Modules                             *[]*databases.Module   `json:"modules,omitempty"`
-	RedisVersion                        *string                `json:"redisVersion,omitempty"`
+	Name                                *string                    `json:"name,omitempty"`
+	Protocol                            *string                    `json:"protocol,omitempty"`
+	MemoryLimitInGB                     *float64                   `json:"memoryLimitInGb,omitempty"`
+	DatasetSizeInGB                     *float64                   `json:"datasetSizeInGb,omitempty"`
+	SupportOSSClusterAPI                *bool                      `json:"supportOSSClusterApi,omitempty"`
+	RespVersion                         *string                    `json:"respVersion,omitempty"`
+	UseExternalEndpointForOSSClusterAPI *bool                      `json:"useExternalEndpointForOSSClusterApi,omitempty"`
+	EnableDatabaseClustering            *bool                      `json:"enableDatabaseClustering,omitempty"`
+	DataPersistence                     *databases.DataPersistence `json:"dataPersistence,omitempty"`
+	DataEvictionPolicy                  *databases.EvictionPolicy  `json:"dataEvictionPolicy,omitempty"`
+	Replication                         *bool                      `json:"replication,omitempty"`
+	PeriodicBackupPath                  *string                    `json:"periodicBackupPath,omitempty"`
+	SourceIPs                           []*string                  `json:"sourceIps,omitempty"`
+	RegexRules                          []*string                  `json:"regexRules,omitempty"`
+	Replica                             *ReplicaOf                 `json:"replica,omitempty"`
+	ClientTlsCertificates               []*DatabaseCertificate     `json:"clientTlsCertificates,omitempty" secret:"true"`
+	EnableTls                           *bool                      `json:"enableTls,omitempty"`
+	Password                            *string                    `json:"password,omitempty" secret:"true"`
+	Alerts                              *[]*databases.Alert        `json:"alerts,omitempty"`
+	Modules                             *[]*databases.Module       `json:"modules,omitempty"`
+	RedisVersion                        *string                    `json:"redisVersion,omitempty"`
 }
 
 type UpdateFixedDatabase struct {
-	Name                                *string                `json:"name,omitempty"`
-	MemoryLimitInGB                     *float64               `json:"memoryLimitInGb,omitempty"`
-	DatasetSizeInGB                     *float64               `json:"datasetSizeInGb,omitempty"`
-	SupportOSSClusterAPI                *bool                  `json:"supportOSSClusterApi,omitempty"`
-	RespVersion                         *string                `json:"respVersion,omitempty"`
-	UseExternalEndpointForOSSClusterAPI *bool                  `json:"useExternalEndpointForOSSClusterApi,omitempty"`
-	EnableDatabaseClustering            *bool                  `json:"enableDatabaseClustering,omitempty"`
-	DataPersistence                     *string                `json:"dataPersistence,omitempty"`
-	DataEvictionPolicy                  *string                `json:"dataEvictionPolicy,omitempty"`
-	Replication                         *bool                  `json:"replication,omitempty"`
-	PeriodicBackupPath                  *string                `json:"periodicBackupPath,omitempty"`
-	SourceIPs                           []*string              `json:"sourceIps,omitempty"`
-	Replica                             *ReplicaOf             `json:"replica,omitempty"`
-	RegexRules                          []*string              `json:"regexRules,omitempty"`
-	ClientTlsCertificates               []*DatabaseCertificate `json:"clientTlsCertificates,omitempty"`
-	EnableTls                           *bool                  `json:"enableTls,omitempty"`
-	Password                            *string                `json:"password,omitempty"`
-	Alerts                              *[]*databases.Alert    `json:"alerts,omitempty"`
+	Name                                *string                            `json:"name,omitempty"`
+	MemoryLimitInGB                     *float64                           `json:"memoryLimitInGb,omitempty"`
+	DatasetSizeInGB                     *float64                           `json:"datasetSizeInGb,omitempty"`
+	SupportOSSClusterAPI                *bool                              `json:"supportOSSClusterApi,omitempty"`
+	RespVersion                         *string                            `json:"respVersion,omitempty"`
+	UseExternalEndpointForOSSClusterAPI *bool                              `json:"useExternalEndpointForOSSClusterApi,omitempty"`
+	EnableDatabaseClustering            *bool                              `json:"enableDatabaseClustering,omitempty"`
+	DataPersistence                     *databases.DataPersistence         `json:"dataPersistence,omitempty"`
+	DataEvictionPolicy                  *databases.EvictionPolicy          `json:"dataEvictionPolicy,omitempty"`
+	Replication                         *bool                              `json:"replication,omitempty"`
+	PeriodicBackupPath                  *string                            `json:"periodicBackupPath,omitempty"`
+	SourceIPs                           []*string                          `json:"sourceIps,omitempty"`
+	Replica                             *ReplicaOf                         `json:"replica,omitempty"`
+	RegexRules                          []*string                          `json:"regexRules,omitempty"`
+	ClientTlsCertificates               []*DatabaseCertificate             `json:"clientTlsCertificates,omitempty" secret:"true"`
+	EnableTls                           *bool                              `json:"enableTls,omitempty"`
+	Password                            *string                            `json:"password,omitempty" secret:"true"`
+	Alerts                              redis.Optional[[]*databases.Alert] `json:"alerts,omitzero"`
 	// As with flexible databases, this is only available on the update endpoint
 	EnableDefaultUser *bool `json:"enableDefaultUser,omitempty"`
 }
 
 type FixedDatabase struct {
-	DatabaseId                          *int       `json:"databaseId,omitempty"`
-	Name                                *string    `json:"name,omitempty"`
-	Protocol                            *string    `json:"protocol,omitempty"`
-	Provider                            *string    `json:"provider,omitempty"`
-	Region                              *string    `json:"region,omitempty"`
-	RedisVersionCompliance              *string    `json:"redisVersionCompliance,omitempty"`
-	RedisVersion                        *string    `json:"redisVersion,omitempty"`
-	RespVersion                         *string    `json:"respVersion,omitempty"`
-	Status                              *string    `json:"status,omitempty"`
-	PlanMemoryLimit                     *float64   `json:"planMemoryLimit,omitempty"`
-	MemoryLimitMeasurementUnit          *string    `json:"memoryLimitMeasurementUnit,omitempty"`
-	MemoryLimitInGb                     *float64   `json:"memoryLimitInGb,omitempty"`
-	DatasetSizeInGB                     *float64   `json:"datasetSizeInGb,omitempty"`
-	MemoryUsedInMb                      *float64   `json:"memoryUsedInMb,omitempty"`
-	NetworkMonthlyUsageInByte           *float64   `json:"networkMonthlyUsageInByte,omitempty"`
-	MemoryStorage                       *string    `json:"memoryStorage,omitempty"`
-	SupportOSSClusterAPI                *bool      `json:"supportOSSClusterApi,omitempty"`
-	UseExternalEndpointForOSSClusterAPI *bool      `json:"useExternalEndpointForOSSClusterApi,omitempty"`
-	DataPersistence                     *string    `json:"dataPersistence,omitempty"`
-	Replication                         *bool      `json:"replication,omitempty"`
-	DataEvictionPolicy                  *string    `json:"dataEvictionPolicy,omitempty"`
-	ActivatedOn                         *time.Time `json:"activatedOn,omitempty"`
-	LastModified                        *time.Time `json:"lastModified,omitempty"`
-	PublicEndpoint                      *string    `json:"publicEndpoint,omitempty"`
-	PrivateEndpoint                     *string    `json:"privateEndpoint,omitempty"`
+	DatabaseId                          *int                       `json:"databaseId,omitempty"`
+	Name                                *string                    `json:"name,omitempty"`
+	Protocol                            *string                    `json:"protocol,omitempty"`
+	Provider                            *string                    `json:"provider,omitempty"`
+	Region                              *string                    `json:"region,omitempty"`
+	RedisVersionCompliance              *string                    `json:"redisVersionCompliance,omitempty"`
+	RedisVersion                        *string                    `json:"redisVersion,omitempty"`
+	RespVersion                         *string                    `json:"respVersion,omitempty"`
+	Status                              *databases.Status          `json:"status,omitempty"`
+	PlanMemoryLimit                     *float64                   `json:"planMemoryLimit,omitempty"`
+	MemoryLimitMeasurementUnit          *string                    `json:"memoryLimitMeasurementUnit,omitempty"`
+	MemoryLimitInGb                     *float64                   `json:"memoryLimitInGb,omitempty"`
+	DatasetSizeInGB                     *float64                   `json:"datasetSizeInGb,omitempty"`
+	MemoryUsedInMb                      *float64                   `json:"memoryUsedInMb,omitempty"`
+	NetworkMonthlyUsageInByte           *float64                   `json:"networkMonthlyUsageInByte,omitempty"`
+	MemoryStorage                       *string                    `json:"memoryStorage,omitempty"`
+	SupportOSSClusterAPI                *bool                      `json:"supportOSSClusterApi,omitempty"`
+	UseExternalEndpointForOSSClusterAPI *bool                      `json:"useExternalEndpointForOSSClusterApi,omitempty"`
+	DataPersistence                     *databases.DataPersistence `json:"dataPersistence,omitempty"`
+	Replication                         *bool                      `json:"replication,omitempty"`
+	DataEvictionPolicy                  *databases.EvictionPolicy  `json:"dataEvictionPolicy,omitempty"`
+	ActivatedOn                         *time.Time                 `json:"activatedOn,omitempty"`
+	LastModified                        *time.Time                 `json:"lastModified,omitempty"`
+	PublicEndpoint                      *string                    `json:"publicEndpoint,omitempty"`
+	PrivateEndpoint                     *string                    `json:"privateEndpoint,omitempty"`
 	// The following are undocumented but are returned
 	Replica    *ReplicaOf           `json:"replica,omitempty"`
 	Clustering *Clustering          `json:"clustering,omitempty"`
@@ -103,7 +104,7 @@ type SyncSource struct {
 	Description *string `json:"description,omitempty"`
 	Endpoint    *string `json:"endpoint,omitempty"`
 	Encryption  *bool   `json:"encryption,omitempty"`
-	ServerCert  *string `json:"serverCert,omitempty"`
+	ServerCert  *string `json:"serverCert,omitempty" secret:"true"`
 }
 
 func (o SyncSource) String() string {
@@ -112,7 +113,7 @@ func (o SyncSource) String() string {
 
 type DatabaseCertificate struct {
 	Description                *string `json:"description,omitempty"`
-	PublicCertificatePEMString *string `json:"publicCertificatePEMString,omitempty"`
+	PublicCertificatePEMString *string `json:"publicCertificatePEMString,omitempty" secret:"true"`
 }
 
 func (o DatabaseCertificate) String() string {
@@ -131,7 +132,7 @@ func (o Clustering) String() string {
 
 type Security struct {
 	EnableDefaultUser       *bool     `json:"defaultUserEnabled,omitempty"`
-	Password                *string   `json:"password,omitempty"`
+	Password                *string   `json:"password,omitempty" secret:"true"`
 	SSLClientAuthentication *bool     `json:"sslClientAuthentication,omitempty"`
 	TLSClientAuthentication *bool     `json:"tlsClientAuthentication,omitempty"`
 	EnableTls               *bool     `json:"enableTls,omitempty"`
@@ -46,7 +46,7 @@ func (a *API) Create(ctx context.Context, subscription int, db CreateFixedDataba
 		return 0, err
 	}
 
-	a.logger.Printf("Waiting for new fixed database for subscription %d to finish being created", subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for new fixed database for subscription %d to finish being created", subscription), internal.SubscriptionID(subscription))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -81,7 +81,7 @@ func (a *API) Update(ctx context.Context, subscription int, database int, update
 		return err
 	}
 
-	a.logger.Printf("Waiting for fixed database %d for subscription %d to finish being updated", database, subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for fixed database %d for subscription %d to finish being updated", database, subscription), internal.DatabaseID(database), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -94,7 +94,7 @@ func (a *API) UpgradeRedisVersion(ctx context.Context, subscription int, databas
 		return err
 	}
 
-	a.logger.Printf("Waiting for fixed database %d for subscription %d to finish being upgraded", database, subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for fixed database %d for subscription %d to finish being upgraded", database, subscription), internal.DatabaseID(database), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -107,7 +107,7 @@ func (a *API) Delete(ctx context.Context, subscription int, database int) error
 		return err
 	}
 
-	a.logger.Printf("Waiting for fixed database %d for subscription %d to finish being deleted", database, subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for fixed database %d for subscription %d to finish being deleted", database, subscription), internal.DatabaseID(database), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -120,7 +120,7 @@ func (a *API) Backup(ctx context.Context, subscription int, database int) error
 		return err
 	}
 
-	a.logger.Printf("Waiting for backup of fixed database %d for subscription %d to finish", database, subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for backup of fixed database %d for subscription %d to finish", database, subscription), internal.DatabaseID(database), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -133,7 +133,7 @@ func (a *API) Import(ctx context.Context, subscription int, database int, reques
 		return err
 	}
 
-	a.logger.Printf("Waiting for import into fixed database %d for subscription %d to finish", database, subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for import into fixed database %d for subscription %d to finish", database, subscription), internal.DatabaseID(database), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -0,0 +1,51 @@
+package databases
+
+import (
+	"fmt"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+)
+
+// Validate checks the request for problems that the API would otherwise reject, without contacting the API.
+func (o CreateFixedDatabase) Validate() error {
+	v := internal.NewValidation("create fixed database request")
+	v.Required("name", o.Name != nil)
+	internal.OneOf(v, "protocol", o.Protocol, ProtocolValues())
+	internal.OneOf(v, "dataPersistence", o.DataPersistence, databases.DataPersistenceValues())
+	internal.OneOf(v, "dataEvictionPolicy", o.DataEvictionPolicy, databases.DataEvictionPolicyValues())
+	v.Exclusive(map[string]bool{"memoryLimitInGb": o.MemoryLimitInGB != nil, "datasetSizeInGb": o.DatasetSizeInGB != nil})
+	internal.Positive(v, "memoryLimitInGb", o.MemoryLimitInGB)
+	internal.Positive(v, "datasetSizeInGb", o.DatasetSizeInGB)
+	v.SourceIPs("sourceIps", o.SourceIPs)
+	if o.Alerts != nil {
+		validateAlerts(v, *o.Alerts)
+	}
+	return v.Err()
+}
+
+// Validate checks the request for problems that the API would otherwise reject, without contacting the API.
+func (o UpdateFixedDatabase) Validate() error {
+	v := internal.NewValidation("update fixed database request")
+	internal.OneOf(v, "dataPersistence", o.DataPersistence, databases.DataPersistenceValues())
+	internal.OneOf(v, "dataEvictionPolicy", o.DataEvictionPolicy, databases.DataEvictionPolicyValues())
+	v.Exclusive(map[string]bool{"memoryLimitInGb": o.MemoryLimitInGB != nil, "datasetSizeInGb": o.DatasetSizeInGB != nil})
+	internal.Positive(v, "memoryLimitInGb", o.MemoryLimitInGB)
+	internal.Positive(v, "datasetSizeInGb", o.DatasetSizeInGB)
+	v.SourceIPs("sourceIps", o.SourceIPs)
+	if alerts, ok := o.Alerts.Get(); ok {
+		validateAlerts(v, alerts)
+	}
+	return v.Err()
+}
+
+func validateAlerts(v *internal.Validation, alerts []*databases.Alert) {
+	for i, alert := range alerts {
+		if alert == nil {
+			continue
+		}
+		v.Required(fmt.Sprintf("alerts[%d].name", i), alert.Name != nil)
+		internal.OneOf(v, fmt.Sprintf("alerts[%d].name", i), alert.Name, databases.AlertNameValues())
+		v.Required(fmt.Sprintf("alerts[%d].value", i), alert.Value != nil)
+	}
+}
@@ -3,7 +3,9 @@ package plan_subscriptions
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
+	"github.com/RedisLabs/rediscloud-go-api/internal"
 	"github.com/RedisLabs/rediscloud-go-api/service/fixed/plans"
 )
 
@@ -36,7 +38,7 @@ func (a *API) List(ctx context.Context, id int) ([]*plans.GetPlanResponse, error
 		return nil, err
 	}
 
-	a.logger.Printf("Listing fixed plans applicable to subscription %d, there are %d available", id, len(response.Plans))
+	internal.LogAttrs(ctx, a.logger, slog.LevelDebug, fmt.Sprintf("Listing fixed plans applicable to subscription %d, there are %d available", id, len(response.Plans)), internal.SubscriptionID(id), slog.Int("count", len(response.Plans)))
 
 	return response.Plans, nil
 }
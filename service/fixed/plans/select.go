@@ -0,0 +1,155 @@
+package plans
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+)
+
+// Requirements are what a plan must offer to be selected. Fields left at their zero value aren't required.
+type Requirements struct {
+	Provider string
+	Region   string
+	// MinSizeGB is the smallest dataset size, in GB
+	MinSizeGB   float64
+	Replication bool
+	Persistence bool
+	Clustering  bool
+	// MinThroughput is the lowest maximum throughput, in operations per second
+	MinThroughput int
+	// Currency excludes the plans priced in any other currency
+	Currency string
+	// MaxMonthlyPrice is the highest price per month, in Currency, which must be set along with it. Hourly prices are
+	// converted with pricing.HoursPerMonth
+	MaxMonthlyPrice float64
+}
+
+// Selection is the outcome of Select: the plans meeting the requirements, best first, and why the others don't.
+type Selection struct {
+	Matches  []*GetPlanResponse
+	Excluded []*Exclusion
+}
+
+// Exclusion is a plan not meeting the requirements, with every reason it doesn't.
+type Exclusion struct {
+	Plan    *GetPlanResponse
+	Reasons []string
+}
+
+// Select returns the plans meeting the requirements, ranked by their price per month and then by size and
+// throughput, from the largest. Plans in different currencies are ranked by currency first, and plans whose price
+// period is neither an hour nor a month come last. Plans of the required provider are listed only, when one is set.
+func (a *API) Select(ctx context.Context, requirements Requirements) (*Selection, error) {
+	if requirements.MaxMonthlyPrice > 0 && requirements.Currency == "" {
+		return nil, errors.New("a currency is needed to compare plans with the maximum monthly price")
+	}
+
+	var list []*GetPlanResponse
+	var err error
+	if requirements.Provider != "" {
+		list, err = a.ListWithProvider(ctx, requirements.Provider)
+	} else {
+		list, err = a.List(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	selection := &Selection{}
+	for _, plan := range list {
+		if reasons := requirements.unmet(plan); len(reasons) > 0 {
+			selection.Excluded = append(selection.Excluded, &Exclusion{Plan: plan, Reasons: reasons})
+		} else {
+			selection.Matches = append(selection.Matches, plan)
+		}
+	}
+
+	sort.SliceStable(selection.Matches, func(i, j int) bool {
+		a, b := selection.Matches[i], selection.Matches[j]
+		if currency := strings.ToUpper(redis.StringValue(a.PriceCurrency)); currency != strings.ToUpper(redis.StringValue(b.PriceCurrency)) {
+			return currency < strings.ToUpper(redis.StringValue(b.PriceCurrency))
+		}
+		priceA, okA := monthlyPrice(a)
+		priceB, okB := monthlyPrice(b)
+		if okA != okB {
+			return okA
+		}
+		if priceA != priceB {
+			return priceA < priceB
+		}
+		if sizeGB(a) != sizeGB(b) {
+			return sizeGB(a) > sizeGB(b)
+		}
+		return redis.IntValue(a.MaximumThroughput) > redis.IntValue(b.MaximumThroughput)
+	})
+	return selection, nil
+}
+
+// unmet returns why a plan doesn't meet the requirements, if it doesn't.
+func (r Requirements) unmet(plan *GetPlanResponse) []string {
+	var reasons []string
+	if r.Provider != "" && !strings.EqualFold(redis.StringValue(plan.Provider), r.Provider) {
+		reasons = append(reasons, fmt.Sprintf("provider is %s, not %s", redis.StringValue(plan.Provider), r.Provider))
+	}
+	if r.Region != "" && !strings.EqualFold(redis.StringValue(plan.Region), r.Region) {
+		reasons = append(reasons, fmt.Sprintf("region is %s, not %s", redis.StringValue(plan.Region), r.Region))
+	}
+	if size := sizeGB(plan); size < r.MinSizeGB {
+		reasons = append(reasons, fmt.Sprintf("size is %g GB, less than %g GB", size, r.MinSizeGB))
+	}
+	if r.Replication && !redis.BoolValue(plan.SupportReplication) {
+		reasons = append(reasons, "replication isn't supported")
+	}
+	if r.Persistence && !redis.BoolValue(plan.SupportDataPersistence) {
+		reasons = append(reasons, "data persistence isn't supported")
+	}
+	if r.Clustering && !redis.BoolValue(plan.SupportClustering) {
+		reasons = append(reasons, "clustering isn't supported")
+	}
+	if throughput := redis.IntValue(plan.MaximumThroughput); throughput < r.MinThroughput {
+		reasons = append(reasons, fmt.Sprintf("throughput is at most %d ops/sec, less than %d", throughput, r.MinThroughput))
+	}
+	currency := redis.StringValue(plan.PriceCurrency)
+	if r.Currency != "" && !strings.EqualFold(currency, r.Currency) {
+		reasons = append(reasons, fmt.Sprintf("currency is %s, not %s", currency, r.Currency))
+	}
+	if r.MaxMonthlyPrice > 0 {
+		if price, ok := monthlyPrice(plan); !ok {
+			reasons = append(reasons, fmt.Sprintf("price is per %s, which can't be compared per month", redis.StringValue(plan.PricePeriod)))
+		} else if price > r.MaxMonthlyPrice {
+			reasons = append(reasons, fmt.Sprintf("price is %g %s per month, more than %g", price, currency, r.MaxMonthlyPrice))
+		}
+	}
+	return reasons
+}
+
+// monthlyPrice returns the price of a plan per month, if it's priced per hour or per month.
+func monthlyPrice(plan *GetPlanResponse) (float64, bool) {
+	price := float64(redis.IntValue(plan.Price))
+	switch strings.ToLower(redis.StringValue(plan.PricePeriod)) {
+	case "month", "monthly":
+		return price, true
+	case "hour", "hourly":
+		return price * pricing.HoursPerMonth, true
+	default:
+		return 0, false
+	}
+}
+
+// sizeGB returns the dataset size of a plan in GB, whatever unit it's given in.
+func sizeGB(plan *GetPlanResponse) float64 {
+	size := redis.Float64Value(plan.Size)
+	switch strings.ToUpper(redis.StringValue(plan.SizeMeasurementUnit)) {
+	case "MB":
+		return size / 1024
+	case "TB":
+		return size * 1024
+	default:
+		return size
+	}
+}
@@ -2,7 +2,11 @@ package plans
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
 	"net/url"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
 )
 
 const root = "/fixed/plans"
@@ -34,7 +38,7 @@ func (a *API) List(ctx context.Context) ([]*GetPlanResponse, error) {
 		return nil, err
 	}
 
-	a.logger.Printf("Listing fixed plans, all cloud providers, there are %d available", len(response.Plans))
+	internal.LogAttrs(ctx, a.logger, slog.LevelDebug, fmt.Sprintf("Listing fixed plans, all cloud providers, there are %d available", len(response.Plans)), slog.Int("count", len(response.Plans)))
 
 	return response.Plans, nil
 }
@@ -52,7 +56,7 @@ func (a *API) ListWithProvider(ctx context.Context, provider string) ([]*GetPlan
 		return nil, err
 	}
 
-	a.logger.Printf("Listing fixed plans for cloud provider %s, there are %d available", provider, len(response.Plans))
+	internal.LogAttrs(ctx, a.logger, slog.LevelDebug, fmt.Sprintf("Listing fixed plans for cloud provider %s, there are %d available", provider, len(response.Plans)), slog.String("provider", provider), slog.Int("count", len(response.Plans)))
 
 	return response.Plans, nil
 }
@@ -0,0 +1,83 @@
+package subscriptions
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	fixedDatabases "github.com/RedisLabs/rediscloud-go-api/service/fixed/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+)
+
+// CascadeDatabases is the part of the fixed databases API used by DeleteCascade.
+type CascadeDatabases interface {
+	List(ctx context.Context, subscription int) *fixedDatabases.ListFixedDatabase
+	Delete(ctx context.Context, subscription int, database int) error
+}
+
+// SetCascadeDatabases provides the databases API used by DeleteCascade - the client does this automatically.
+func (a *API) SetCascadeDatabases(databases CascadeDatabases) {
+	a.databases = databases
+}
+
+// DeleteCascade deletes a fixed subscription along with all of its databases. It behaves like the Pro
+// subscriptions.API DeleteCascade: the options support a dry run and require the subscription name (or ID, when it
+// has no name) as confirmation, and the returned plan lists every step in the order it's taken.
+func (a *API) DeleteCascade(ctx context.Context, id int, options subscriptions.DeleteCascadeOptions) (*subscriptions.DeletionPlan, error) {
+	subscription, err := a.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	type step struct {
+		description string
+		run         func(ctx context.Context) error
+	}
+
+	var steps []step
+	if a.databases != nil {
+		list := a.databases.List(ctx, id)
+		for list.Next() {
+			dbId := redis.IntValue(list.Value().DatabaseId)
+			steps = append(steps, step{
+				description: fmt.Sprintf("delete database %d (%s)", dbId, redis.StringValue(list.Value().Name)),
+				run: func(ctx context.Context) error {
+					return a.databases.Delete(ctx, id, dbId)
+				},
+			})
+		}
+		if list.Err() != nil {
+			return nil, list.Err()
+		}
+	}
+	steps = append(steps, step{
+		description: fmt.Sprintf("delete subscription %d", id),
+		run: func(ctx context.Context) error {
+			return a.Delete(ctx, id)
+		},
+	})
+
+	plan := &subscriptions.DeletionPlan{SubscriptionID: id}
+	for _, s := range steps {
+		plan.Steps = append(plan.Steps, s.description)
+	}
+
+	if options.DryRun {
+		return plan, nil
+	}
+
+	if err := options.Confirms(id, subscription.Name); err != nil {
+		return plan, err
+	}
+
+	for i, s := range steps {
+		internal.LogInfo(ctx, a.logger, fmt.Sprintf("Fixed subscription %d teardown step %d/%d: %s", id, i+1, len(steps), s.description), internal.SubscriptionID(id), slog.Int("step", i+1))
+		if err := s.run(ctx); err != nil {
+			return plan, fmt.Errorf("failed to %s: %w", s.description, err)
+		}
+	}
+
+	return plan, nil
+}
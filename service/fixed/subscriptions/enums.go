@@ -0,0 +1,42 @@
+package subscriptions
+
+import "slices"
+
+// Status is the value of the `Status` field in `FixedSubscriptionResponse`. Values introduced by the API after this version of the
+// library are unmarshalled as-is rather than rejected, and report false from IsValid.
+type Status string
+
+const (
+	// FixedSubscriptionStatusActive is the active value of the `Status` field in `Subscription`
+	FixedSubscriptionStatusActive Status = "active"
+	// FixedSubscriptionStatusPending is the pending value of the `Status` field in `Subscription`
+	FixedSubscriptionStatusPending Status = "pending"
+	// FixedSubscriptionStatusError is the error value of the `Status` field in `Subscription`
+	FixedSubscriptionStatusError Status = "error"
+	// FixedSubscriptionStatusDeleting is the deleting value of the `Status` field in `Subscription`
+	FixedSubscriptionStatusDeleting Status = "deleting"
+)
+
+func StatusValues() []Status {
+	return []Status{
+		FixedSubscriptionStatusActive,
+		FixedSubscriptionStatusPending,
+		FixedSubscriptionStatusError,
+		FixedSubscriptionStatusDeleting,
+	}
+}
+
+// IsValid reports whether the status is one known to this version of the library.
+func (s Status) IsValid() bool {
+	return slices.Contains(StatusValues(), s)
+}
+
+// IsTerminal reports whether the subscription has settled, i.e. it won't change status again without another request.
+func (s Status) IsTerminal() bool {
+	return s == FixedSubscriptionStatusActive || s == FixedSubscriptionStatusError
+}
+
+// IsError reports whether the subscription has failed.
+func (s Status) IsError() bool {
+	return s == FixedSubscriptionStatusError
+}
@@ -19,7 +19,7 @@ type FixedSubscriptionRequest struct {
 type FixedSubscriptionResponse struct {
 	ID              *int       `json:"id,omitempty"`
 	Name            *string    `json:"name,omitempty"`
-	Status          *string    `json:"status,omitempty"`
+	Status          *Status    `json:"status,omitempty"`
 	PlanId          *int       `json:"planId,omitempty"`
 	PaymentMethod   *string    `json:"paymentMethodType,omitempty"`
 	PaymentMethodID *int       `json:"paymentMethodId,omitempty"`
@@ -45,14 +45,3 @@ type NotFound struct {
 func (f *NotFound) Error() string {
 	return fmt.Sprintf("fixed subscription %d not found", f.ID)
 }
-
-const (
-	// FixedSubscriptionStatusActive is the active value of the `Status` field in `Subscription`
-	FixedSubscriptionStatusActive = "active"
-	// FixedSubscriptionStatusPending is the pending value of the `Status` field in `Subscription`
-	FixedSubscriptionStatusPending = "pending"
-	// FixedSubscriptionStatusError is the error value of the `Status` field in `Subscription`
-	FixedSubscriptionStatusError = "error"
-	// FixedSubscriptionStatusDeleting is the deleting value of the `Status` field in `Subscription`
-	FixedSubscriptionStatusDeleting = "deleting"
-)
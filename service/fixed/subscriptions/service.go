@@ -29,6 +29,7 @@ type API struct {
 	client     HttpClient
 	taskWaiter TaskWaiter
 	logger     Log
+	databases  CascadeDatabases
 }
 
 func NewAPI(client HttpClient, taskWaiter TaskWaiter, logger Log) *API {
@@ -43,7 +44,7 @@ func (a *API) Create(ctx context.Context, subscription FixedSubscriptionRequest)
 		return 0, err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish creating the fixed subscription", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish creating the fixed subscription", task), internal.TaskID(task.ID))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -83,7 +84,7 @@ func (a *API) Update(ctx context.Context, id int, subscription FixedSubscription
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for task %s to finish updating the fixed subscription", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish updating the fixed subscription", task), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
@@ -102,7 +103,7 @@ func (a *API) Delete(ctx context.Context, id int) error {
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for fixed subscription %d to finish being deleted", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for fixed subscription %d to finish being deleted", id), internal.SubscriptionID(id))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -0,0 +1,19 @@
+package subscriptions
+
+import (
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+)
+
+// Validate checks the request for problems that the API would otherwise reject, without contacting the API.
+func (o FixedSubscriptionRequest) Validate() error {
+	v := internal.NewValidation("fixed subscription request")
+	v.Required("name", o.Name != nil)
+	v.Required("planId", o.PlanId != nil)
+	internal.OneOf(v, "paymentMethod", o.PaymentMethod, subscriptions.PaymentMethodValues())
+	if redis.StringValue(o.PaymentMethod) == subscriptions.PaymentMethodMarketplace && o.PaymentMethodID != nil {
+		v.Addf("paymentMethodId can't be set when paymentMethod is %q", subscriptions.PaymentMethodMarketplace)
+	}
+	return v.Err()
+}
@@ -68,7 +68,7 @@ func (a *API) GetActiveActive(ctx context.Context, subscription int, database in
 		return nil, err
 	}
 
-	a.logger.Printf("Waiting for backup status request %d to complete", task.ID)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for backup status request %s to complete", *task.ID), internal.TaskID(task.ID))
 
 	taskResp, err := a.taskWaiter.WaitForTask(ctx, *task.ID)
 	if err != nil {
@@ -94,7 +94,7 @@ func (a *API) get(ctx context.Context, message string, address string) (*LatestB
 		return nil, err
 	}
 
-	a.logger.Printf("Waiting for backup status request %d to complete", task.ID)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for backup status request %s to complete", *task.ID), internal.TaskID(task.ID))
 
 	taskResp, err := a.taskWaiter.WaitForTask(ctx, *task.ID)
 	if err != nil {
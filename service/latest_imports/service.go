@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 
 	"github.com/RedisLabs/rediscloud-go-api/internal"
@@ -59,7 +60,7 @@ func (a *API) get(ctx context.Context, message string, address string) (*LatestI
 		return nil, err
 	}
 
-	a.logger.Printf("Waiting for import status request %d to complete", task.ID)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for import status request %s to complete", *task.ID), internal.TaskID(task.ID))
 
 	taskResp, err := a.taskWaiter.WaitForTask(ctx, *task.ID)
 	if err != nil {
@@ -74,7 +75,7 @@ func (a *API) get(ctx context.Context, message string, address string) (*LatestI
 		return nil, fmt.Errorf("failed to retrieve completed backup status %d: %w", task.ID, err)
 	}
 
-	a.logger.Printf("Import status request %d completed, possibly with error: %v", task.ID, err)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Import status request %s completed, possibly with error: %v", *task.ID, err), internal.TaskID(task.ID), slog.Any("error", err))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve completed import status %d: %w", task.ID, err)
@@ -0,0 +1,22 @@
+package maintenance
+
+// ToUpdate returns a copy of the maintenance settings which can be sent to Update, e.g. to apply the same windows
+// to another subscription. Windows are only kept in manual mode, as they have no meaning otherwise.
+func (o Maintenance) ToUpdate() Maintenance {
+	update := Maintenance{Mode: o.Mode}
+	if o.Mode != nil && *o.Mode != "manual" {
+		return update
+	}
+	for _, window := range o.Windows {
+		if window == nil {
+			continue
+		}
+		copied := &Window{
+			StartHour:       window.StartHour,
+			DurationInHours: window.DurationInHours,
+		}
+		copied.Days = append(copied.Days, window.Days...)
+		update.Windows = append(update.Windows, copied)
+	}
+	return update
+}
@@ -51,7 +51,7 @@ func (a *API) Update(ctx context.Context, subscription int, m Maintenance) error
 		return err
 	}
 
-	a.logger.Printf("Waiting for fixed database %d for subscription %d to finish being updated", subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for the maintenance windows of subscription %d to finish being updated", subscription), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
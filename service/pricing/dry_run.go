@@ -0,0 +1,38 @@
+package pricing
+
+import (
+	"encoding/json"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+)
+
+// DryRunResult is the outcome of a request made as a dry run: whether the API would accept it, and what the resources
+// it creates or changes would cost.
+type DryRunResult struct {
+	// Valid is false when the API would refuse the request, for the reason given by Refusal
+	Valid   bool       `json:"valid"`
+	Refusal error      `json:"-"`
+	Pricing []*Pricing `json:"pricing,omitempty"`
+}
+
+// MarshalJSON writes the refusal as its message, as errors have no JSON form of their own.
+func (o DryRunResult) MarshalJSON() ([]byte, error) {
+	type result DryRunResult
+	output := struct {
+		result
+		Refusal string `json:"refusal,omitempty"`
+	}{result: result(o)}
+	if o.Refusal != nil {
+		output.Refusal = o.Refusal.Error()
+	}
+	return json.Marshal(output)
+}
+
+func (o DryRunResult) String() string {
+	return internal.ToString(o)
+}
+
+// NewDryRunResult returns the outcome of a dry run, from the refusal and pricing its task was processed with.
+func NewDryRunResult(refusal error, pricing ListPricingResponse) *DryRunResult {
+	return &DryRunResult{Valid: refusal == nil, Refusal: refusal, Pricing: pricing.Pricing}
+}
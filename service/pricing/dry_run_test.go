@@ -0,0 +1,17 @@
+package pricing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRunResult_String(t *testing.T) {
+	refused := NewDryRunResult(errors.New("memory limit is too high"), ListPricingResponse{})
+	assert.Equal(t, `{"valid":false,"refusal":"memory limit is too high"}`, refused.String())
+
+	valid := NewDryRunResult(nil, ListPricingResponse{Pricing: []*Pricing{{Type: redis.String("Shards")}}})
+	assert.Equal(t, `{"valid":true,"pricing":[{"type":"Shards"}]}`, valid.String())
+}
@@ -0,0 +1,90 @@
+package pricing
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+)
+
+// HoursPerMonth converts between hourly and monthly prices: 730 hours, the average month of a year.
+const HoursPerMonth = 730
+
+// Cost is what pricing lines add up to in a currency.
+type Cost struct {
+	Currency string  `json:"currency"`
+	Hourly   float64 `json:"hourly"`
+	Monthly  float64 `json:"monthly"`
+}
+
+// Costs are the totals of pricing lines, one per currency, ordered by currency.
+type Costs []Cost
+
+// Total adds up what pricing lines, of an estimate or an existing subscription, cost per hour and per month in each
+// currency. Lines priced per hour or per month are counted, while others, such as one-off charges, are left out.
+func Total(pricing []*Pricing) Costs {
+	totals := map[string]*Cost{}
+	for _, line := range pricing {
+		hourly, monthly, ok := prices(line)
+		if !ok {
+			continue
+		}
+		currency := redis.StringValue(line.PriceCurrency)
+		if totals[currency] == nil {
+			totals[currency] = &Cost{Currency: currency}
+		}
+		totals[currency].Hourly += hourly
+		totals[currency].Monthly += monthly
+	}
+
+	costs := make(Costs, 0, len(totals))
+	for _, total := range totals {
+		costs = append(costs, *total)
+	}
+	sort.Slice(costs, func(i, j int) bool { return costs[i].Currency < costs[j].Currency })
+	return costs
+}
+
+// TotalByDatabase adds up pricing lines as Total does, per database name. Lines which aren't for a database, such as
+// networking, are added up under "".
+func TotalByDatabase(pricing []*Pricing) map[string]Costs {
+	return totalBy(pricing, func(line *Pricing) string { return redis.StringValue(line.DatabaseName) })
+}
+
+// TotalByRegion adds up pricing lines as Total does, per region. Lines which aren't for a region are added up
+// under "".
+func TotalByRegion(pricing []*Pricing) map[string]Costs {
+	return totalBy(pricing, func(line *Pricing) string { return redis.StringValue(line.Region) })
+}
+
+func totalBy(pricing []*Pricing, key func(line *Pricing) string) map[string]Costs {
+	groups := map[string][]*Pricing{}
+	for _, line := range pricing {
+		groups[key(line)] = append(groups[key(line)], line)
+	}
+
+	totals := make(map[string]Costs, len(groups))
+	for group, lines := range groups {
+		totals[group] = Total(lines)
+	}
+	return totals
+}
+
+// Recurring reports whether a pricing line is priced per hour or per month, and so counted by Total.
+func Recurring(line *Pricing) bool {
+	_, _, ok := prices(line)
+	return ok
+}
+
+// prices returns what a pricing line costs per hour and per month, if it's priced per hour or per month.
+func prices(line *Pricing) (hourly float64, monthly float64, ok bool) {
+	price := float64(redis.IntValue(line.Quantity)) * redis.Float64Value(line.PricePerUnit)
+	switch strings.ToLower(redis.StringValue(line.PricePeriod)) {
+	case "hour", "hourly":
+		return price, price * HoursPerMonth, true
+	case "month", "monthly":
+		return price / HoursPerMonth, price, true
+	default:
+		return 0, 0, false
+	}
+}
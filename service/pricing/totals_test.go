@@ -0,0 +1,73 @@
+package pricing
+
+import (
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func line(database, region, currency, period string, quantity int, price float64) *Pricing {
+	return &Pricing{
+		DatabaseName:  redis.String(database),
+		Region:        redis.String(region),
+		PriceCurrency: redis.String(currency),
+		PricePeriod:   redis.String(period),
+		Quantity:      redis.Int(quantity),
+		PricePerUnit:  redis.Float64(price),
+	}
+}
+
+var lines = []*Pricing{
+	line("cache", "us-east-1", "USD", "hour", 2, 0.5),
+	line("cache", "eu-west-1", "USD", "hour", 1, 0.25),
+	line("sessions", "us-east-1", "USD", "Month", 1, 73),
+	line("", "us-east-1", "EUR", "hour", 1, 0.1),
+	// One-off charges aren't part of hourly or monthly totals
+	line("", "us-east-1", "USD", "once", 1, 100),
+}
+
+func assertCosts(t *testing.T, expected Costs, actual Costs) {
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		assert.Equal(t, expected[i].Currency, actual[i].Currency)
+		assert.InDelta(t, expected[i].Hourly, actual[i].Hourly, 1e-9)
+		assert.InDelta(t, expected[i].Monthly, actual[i].Monthly, 1e-9)
+	}
+}
+
+func TestTotal(t *testing.T) {
+	assertCosts(t, Costs{
+		{Currency: "EUR", Hourly: 0.1, Monthly: 73},
+		{Currency: "USD", Hourly: 1.35, Monthly: 985.5},
+	}, Total(lines))
+
+	assert.Empty(t, Total(nil))
+}
+
+func TestRecurring(t *testing.T) {
+	assert.True(t, Recurring(lines[0]))
+	assert.True(t, Recurring(lines[2]))
+	assert.False(t, Recurring(lines[4]))
+}
+
+func TestTotalByDatabase(t *testing.T) {
+	actual := TotalByDatabase(lines)
+
+	require.Len(t, actual, 3)
+	assertCosts(t, Costs{{Currency: "USD", Hourly: 1.25, Monthly: 912.5}}, actual["cache"])
+	assertCosts(t, Costs{{Currency: "USD", Hourly: 0.1, Monthly: 73}}, actual["sessions"])
+	assertCosts(t, Costs{{Currency: "EUR", Hourly: 0.1, Monthly: 73}}, actual[""])
+}
+
+func TestTotalByRegion(t *testing.T) {
+	actual := TotalByRegion(lines)
+
+	require.Len(t, actual, 2)
+	assertCosts(t, Costs{
+		{Currency: "EUR", Hourly: 0.1, Monthly: 73},
+		{Currency: "USD", Hourly: 1.1, Monthly: 803},
+	}, actual["us-east-1"])
+	assertCosts(t, Costs{{Currency: "USD", Hourly: 0.25, Monthly: 182.5}}, actual["eu-west-1"])
+}
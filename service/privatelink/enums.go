@@ -0,0 +1,87 @@
+package privatelink
+
+import "slices"
+
+// Status is the value of the `Status` field in `PrivateLink`. Values introduced by the API after this version of the
+// library are unmarshalled as-is rather than rejected, and report false from IsValid.
+type Status string
+
+const (
+	// PrivateLinkStatusInitializing when PrivateLink is initialising
+	PrivateLinkStatusInitializing Status = "initializing"
+	// PrivateLinkStatusDeleted when PrivateLink has been deleted
+	PrivateLinkStatusDeleted Status = "deleting"
+	// PrivateLinkStatusActive when PrivateLink is ready
+	PrivateLinkStatusActive Status = "active"
+)
+
+func StatusValues() []Status {
+	return []Status{
+		PrivateLinkStatusInitializing,
+		PrivateLinkStatusDeleted,
+		PrivateLinkStatusActive,
+	}
+}
+
+// IsValid reports whether the status is one known to this version of the library.
+func (s Status) IsValid() bool {
+	return slices.Contains(StatusValues(), s)
+}
+
+// IsTerminal reports whether the PrivateLink has settled, i.e. it won't change status again without another request.
+func (s Status) IsTerminal() bool {
+	return s == PrivateLinkStatusActive
+}
+
+// IsError reports whether the PrivateLink has failed. The API has no failed status for PrivateLink itself, so this is
+// always false; it exists for symmetry with the other status types.
+func (s Status) IsError() bool {
+	return false
+}
+
+// PrincipalStatus is the value of the `Status` field in `PrivateLinkPrincipal`.
+type PrincipalStatus string
+
+const (
+	// PrivateLinkPrincipalStatusInitializing when PrivateLinkPrincipal is initializing
+	PrivateLinkPrincipalStatusInitializing PrincipalStatus = "initializing"
+
+	// PrivateLinkPrincipalStatusDisassociating when PrivateLinkPrincipal is disassociating
+	PrivateLinkPrincipalStatusDisassociating PrincipalStatus = "disassociating"
+	// PrivateLinkPrincipalStatusDisassociated when PrivateLinkPrincipal has disassociated
+	PrivateLinkPrincipalStatusDisassociated PrincipalStatus = "disassociated"
+
+	// PrivateLinkPrincipalStatusAssociating when PrivateLinkPrincipal is associating
+	PrivateLinkPrincipalStatusAssociating PrincipalStatus = "associating"
+	// PrivateLinkPrincipalStatusAssociated when PrivateLinkPrincipal has associated
+	PrivateLinkPrincipalStatusAssociated PrincipalStatus = "associated"
+
+	// PrivateLinkPrincipalStatusFailed when PrivateLinkPrincipal has failed
+	PrivateLinkPrincipalStatusFailed PrincipalStatus = "failed"
+)
+
+func PrincipalStatusValues() []PrincipalStatus {
+	return []PrincipalStatus{
+		PrivateLinkPrincipalStatusInitializing,
+		PrivateLinkPrincipalStatusDisassociating,
+		PrivateLinkPrincipalStatusDisassociated,
+		PrivateLinkPrincipalStatusAssociating,
+		PrivateLinkPrincipalStatusAssociated,
+		PrivateLinkPrincipalStatusFailed,
+	}
+}
+
+// IsValid reports whether the status is one known to this version of the library.
+func (s PrincipalStatus) IsValid() bool {
+	return slices.Contains(PrincipalStatusValues(), s)
+}
+
+// IsTerminal reports whether the principal has settled, i.e. it won't change status again without another request.
+func (s PrincipalStatus) IsTerminal() bool {
+	return s == PrivateLinkPrincipalStatusAssociated || s == PrivateLinkPrincipalStatusDisassociated || s == PrivateLinkPrincipalStatusFailed
+}
+
+// IsError reports whether associating the principal has failed.
+func (s PrincipalStatus) IsError() bool {
+	return s == PrivateLinkPrincipalStatusFailed
+}
@@ -13,7 +13,7 @@ type CreatePrivateLink struct {
 }
 
 type PrivateLink struct {
-	Status                   *string                  `json:"status,omitempty"`
+	Status                   *Status                  `json:"status,omitempty"`
 	Principals               []*PrivateLinkPrincipal  `json:"principals,omitempty"`
 	ResourceConfigurationId  *string                  `json:"resourceConfigurationId,omitempty"`
 	ResourceConfigurationArn *string                  `json:"resourceConfigurationArn,omitempty"`
@@ -27,10 +27,10 @@ type PrivateLink struct {
 }
 
 type PrivateLinkPrincipal struct {
-	Principal *string `json:"principal,omitempty"`
-	Type      *string `json:"type,omitempty"`
-	Alias     *string `json:"alias,omitempty"`
-	Status    *string `json:"status,omitempty"`
+	Principal *string          `json:"principal,omitempty"`
+	Type      *string          `json:"type,omitempty"`
+	Alias     *string          `json:"alias,omitempty"`
+	Status    *PrincipalStatus `json:"status,omitempty"`
 }
 
 type PrivateLinkConnection struct {
@@ -91,28 +91,3 @@ type PrivateLinkEndpointScript struct {
 	ResourceEndpointScript *string `json:"resourceEndpointScript,omitempty"`
 	TerraformAwsScript     *string `json:"terraformAwsScript,omitempty"`
 }
-
-const (
-	// PrivateLinkStatusInitializing when PrivateLink is initialising
-	PrivateLinkStatusInitializing = "initializing"
-	// PrivateLinkStatusDeleted when PrivateLink has been deleted
-	PrivateLinkStatusDeleted = "deleting"
-	// PrivateLinkStatusActive when PrivateLink is ready
-	PrivateLinkStatusActive = "active"
-
-	// PrivateLinkPrincipalStatusInitializing when PrivateLinkPrincipal is initializing
-	PrivateLinkPrincipalStatusInitializing = "initializing"
-
-	// PrivateLinkPrincipalStatusDisassociating when PrivateLinkPrincipal is disassociating
-	PrivateLinkPrincipalStatusDisassociating = "disassociating"
-	// PrivateLinkPrincipalStatusDisassociated when PrivateLinkPrincipal has disassociated
-	PrivateLinkPrincipalStatusDisassociated = "disassociated"
-
-	// PrivateLinkPrincipalStatusAssociating when PrivateLinkPrincipal is associating
-	PrivateLinkPrincipalStatusAssociating = "associating"
-	// PrivateLinkPrincipalStatusAssociated when PrivateLinkPrincipal has associated
-	PrivateLinkPrincipalStatusAssociated = "associated"
-
-	// PrivateLinkPrincipalStatusFailed when PrivateLinkPrincipal has failed
-	PrivateLinkPrincipalStatusFailed = "failed"
-)
@@ -200,7 +200,7 @@ func (a *API) create(ctx context.Context, message string, path string, requestBo
 		return err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish creating the PrivateLink", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish creating the PrivateLink", task), internal.TaskID(task.ID))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -217,7 +217,7 @@ func (a *API) get(ctx context.Context, message string, path string) (*PrivateLin
 		return nil, err
 	}
 
-	a.logger.Printf("Waiting for PrivateLink get request %d to complete", task.ID)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for PrivateLink get request %s to complete", *task.ID), internal.TaskID(task.ID))
 
 	var response PrivateLink
 	err = a.taskWaiter.WaitForResource(ctx, *task.ID, &response)
@@ -241,7 +241,7 @@ func (a *API) getScript(ctx context.Context, message string, path string) (*Priv
 		return nil, err
 	}
 
-	a.logger.Printf("Waiting for PrivateLink script get request %s to complete", *task.ID)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for PrivateLink script get request %s to complete", *task.ID), internal.TaskID(task.ID))
 
 	var response PrivateLinkEndpointScript
 	err = a.taskWaiter.WaitForResource(ctx, *task.ID, &response)
@@ -259,7 +259,7 @@ func (a *API) delete(ctx context.Context, message string, path string, requestBo
 		return err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish deleting the PrivateLink", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish deleting the PrivateLink", task), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
@@ -0,0 +1,115 @@
+package psc
+
+import "slices"
+
+// ServiceStatus is the value of the `Status` field in `PrivateServiceConnectService`. Values introduced by the API
+// after this version of the library are unmarshalled as-is rather than rejected, and report false from IsValid.
+type ServiceStatus string
+
+const (
+	// ServiceStatusCreateQueued when PSC service creation is queued
+	ServiceStatusCreateQueued ServiceStatus = "create-queued"
+	// ServiceStatusDeleteQueued when PSC service deletion is queued
+	ServiceStatusDeleteQueued ServiceStatus = "delete-queued"
+	// ServiceStatusInitialized when PSC service provisioning started
+	ServiceStatusInitialized ServiceStatus = "initialized"
+	// ServiceStatusCreatePending when PSC service provisioning completed but databases are pending update
+	ServiceStatusCreatePending ServiceStatus = "create-pending"
+	// ServiceStatusActive when PSC service is ready
+	ServiceStatusActive ServiceStatus = "active"
+	// ServiceStatusDeletePending when infrastructure deletion is completed but databases are pending update
+	ServiceStatusDeletePending ServiceStatus = "delete-pending"
+	// ServiceStatusDeleted when PSC service is deleted
+	ServiceStatusDeleted ServiceStatus = "deleted"
+	// ServiceStatusProvisionFailed when PSC service has failed while creation/deletion
+	ServiceStatusProvisionFailed ServiceStatus = "provision-failed"
+	// ServiceStatusFailed when PSC service failed after it's been reported as active
+	ServiceStatusFailed ServiceStatus = "failed"
+)
+
+func ServiceStatusValues() []ServiceStatus {
+	return []ServiceStatus{
+		ServiceStatusCreateQueued,
+		ServiceStatusDeleteQueued,
+		ServiceStatusInitialized,
+		ServiceStatusCreatePending,
+		ServiceStatusActive,
+		ServiceStatusDeletePending,
+		ServiceStatusDeleted,
+		ServiceStatusProvisionFailed,
+		ServiceStatusFailed,
+	}
+}
+
+// IsValid reports whether the status is one known to this version of the library.
+func (s ServiceStatus) IsValid() bool {
+	return slices.Contains(ServiceStatusValues(), s)
+}
+
+// IsTerminal reports whether the service has settled, i.e. it won't change status again without another request.
+func (s ServiceStatus) IsTerminal() bool {
+	return s == ServiceStatusActive || s == ServiceStatusDeleted || s.IsError()
+}
+
+// IsError reports whether the service has failed.
+func (s ServiceStatus) IsError() bool {
+	return s == ServiceStatusProvisionFailed || s == ServiceStatusFailed
+}
+
+// EndpointStatus is the value of the `Status` field in `PrivateServiceConnectEndpoint`.
+type EndpointStatus string
+
+const (
+	// EndpointStatusInitialized the endpoint was created in the SM but the creation script wasn't yet run
+	EndpointStatusInitialized EndpointStatus = "initialized"
+	// EndpointStatusProcessing Processing the status during deletion or creation of 40 endpoints in cloud provider
+	EndpointStatusProcessing EndpointStatus = "processing"
+	// EndpointStatusPending the endpoint is waiting for the user to accept or reject it
+	EndpointStatusPending EndpointStatus = "pending"
+	// EndpointStatusAcceptPending the user accepted. the endpoint is not yet fully accepted
+	EndpointStatusAcceptPending EndpointStatus = "accept-pending"
+	// EndpointStatusActive the endpoint is ready for use
+	EndpointStatusActive EndpointStatus = "active"
+	// EndpointStatusDeleted the endpoint was successfully deleted
+	EndpointStatusDeleted EndpointStatus = "deleted"
+	// EndpointStatusRejected the endpoint was successfully rejected
+	EndpointStatusRejected EndpointStatus = "rejected"
+	// EndpointStatusRejectPending the user rejected. the endpoint is not yet fully rejected
+	EndpointStatusRejectPending EndpointStatus = "reject-pending"
+	// EndpointStatusFailed endpoint is in error status
+	EndpointStatusFailed EndpointStatus = "failed"
+)
+
+func EndpointStatusValues() []EndpointStatus {
+	return []EndpointStatus{
+		EndpointStatusInitialized,
+		EndpointStatusProcessing,
+		EndpointStatusPending,
+		EndpointStatusAcceptPending,
+		EndpointStatusActive,
+		EndpointStatusDeleted,
+		EndpointStatusRejected,
+		EndpointStatusRejectPending,
+		EndpointStatusFailed,
+	}
+}
+
+// IsValid reports whether the status is one known to this version of the library.
+func (s EndpointStatus) IsValid() bool {
+	return slices.Contains(EndpointStatusValues(), s)
+}
+
+// IsTerminal reports whether the endpoint has settled. An endpoint that's pending is waiting on the user to accept or
+// reject it, so it counts as settled.
+func (s EndpointStatus) IsTerminal() bool {
+	switch s {
+	case EndpointStatusPending, EndpointStatusActive, EndpointStatusDeleted, EndpointStatusRejected, EndpointStatusFailed:
+		return true
+	}
+	return false
+}
+
+// IsError reports whether the endpoint has failed.
+func (s EndpointStatus) IsError() bool {
+	return s == EndpointStatusFailed
+}
@@ -5,10 +5,10 @@ import (
 )
 
 type PrivateServiceConnectService struct {
-	ID                    *int    `json:"id,omitempty"`
-	ConnectionHostName    *string `json:"connectionHostName,omitempty"`
-	ServiceAttachmentName *string `json:"serviceAttachmentName,omitempty"`
-	Status                *string `json:"status,omitempty"`
+	ID                    *int           `json:"id,omitempty"`
+	ConnectionHostName    *string        `json:"connectionHostName,omitempty"`
+	ServiceAttachmentName *string        `json:"serviceAttachmentName,omitempty"`
+	Status                *ServiceStatus `json:"status,omitempty"`
 }
 
 type CreatePrivateServiceConnectEndpoint struct {
@@ -31,12 +31,12 @@ type PrivateServiceConnectEndpoints struct {
 	Endpoints    []*PrivateServiceConnectEndpoint `json:"endpoints,omitempty"`
 }
 type PrivateServiceConnectEndpoint struct {
-	ID                     *int    `json:"id,omitempty"`
-	GCPProjectID           *string `json:"gcpProjectId,omitempty"`
-	GCPVPCName             *string `json:"gcpVpcName,omitempty"`
-	GCPVPCSubnetName       *string `json:"gcpVpcSubnetName,omitempty"`
-	EndpointConnectionName *string `json:"endpointConnectionName,omitempty"`
-	Status                 *string `json:"status,omitempty"`
+	ID                     *int            `json:"id,omitempty"`
+	GCPProjectID           *string         `json:"gcpProjectId,omitempty"`
+	GCPVPCName             *string         `json:"gcpVpcName,omitempty"`
+	GCPVPCSubnetName       *string         `json:"gcpVpcSubnetName,omitempty"`
+	EndpointConnectionName *string         `json:"endpointConnectionName,omitempty"`
+	Status                 *EndpointStatus `json:"status,omitempty"`
 }
 
 type CreationScript struct {
@@ -87,45 +87,6 @@ func (f *NotFoundActiveActive) Error() string {
 }
 
 const (
-
-	// ServiceStatusCreateQueued when PSC service creation is queued
-	ServiceStatusCreateQueued = "create-queued"
-	// ServiceStatusDeleteQueued when PSC service deletion is queued
-	ServiceStatusDeleteQueued = "delete-queued"
-	// ServiceStatusInitialized when PSC service provisioning started
-	ServiceStatusInitialized = "initialized"
-	// ServiceStatusCreatePending when PSC service provisioning completed but databases are pending update
-	ServiceStatusCreatePending = "create-pending"
-	// ServiceStatusActive when PSC service is ready
-	ServiceStatusActive = "active"
-	// ServiceStatusDeletePending when infrastructure deletion is completed but databases are pending update
-	ServiceStatusDeletePending = "delete-pending"
-	// ServiceStatusDeleted when PSC service is deleted
-	ServiceStatusDeleted = "deleted"
-	// ServiceStatusProvisionFailed when PSC service has failed while creation/deletion
-	ServiceStatusProvisionFailed = "provision-failed"
-	// ServiceStatusFailed when PSC service failed after it's been reported as active
-	ServiceStatusFailed = "failed"
-
-	// EndpointStatusInitialized the endpoint was created in the SM but the creation script wasn't yet run
-	EndpointStatusInitialized = "initialized"
-	// EndpointStatusProcessing Processing the status during deletion or creation of 40 endpoints in cloud provider
-	EndpointStatusProcessing = "processing"
-	// EndpointStatusPending the endpoint is waiting for the user to accept or reject it
-	EndpointStatusPending = "pending"
-	// EndpointStatusAcceptPending the user accepted. the endpoint is not yet fully accepted
-	EndpointStatusAcceptPending = "accept-pending"
-	// EndpointStatusActive the endpoint is ready for use
-	EndpointStatusActive = "active"
-	// EndpointStatusDeleted the endpoint was successfully deleted
-	EndpointStatusDeleted = "deleted"
-	// EndpointStatusRejected the endpoint was successfully rejected
-	EndpointStatusRejected = "rejected"
-	// EndpointStatusRejectPending the user rejected. the endpoint is not yet fully rejected
-	EndpointStatusRejectPending = "reject-pending"
-	// EndpointStatusFailed endpoint is in error status
-	EndpointStatusFailed = "failed"
-
 	// EndpointActionAccept accepts the endpoint
 	EndpointActionAccept = "accept"
 	// EndpointActionReject rejects the endpoint
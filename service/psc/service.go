@@ -161,7 +161,7 @@ func (a *API) CreateEndpoint(ctx context.Context, subscription int, pscServiceId
 		return 0, err
 	}
 
-	a.logger.Printf("Waiting for private service connect endpoint for subscription %d and service %d to finish being created", subscription, pscServiceId)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for private service connect endpoint for subscription %d and service %d to finish being created", subscription, pscServiceId), internal.SubscriptionID(subscription), internal.ResourceID("psc_service", pscServiceId))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -181,7 +181,7 @@ func (a *API) CreateActiveActiveEndpoint(ctx context.Context, subscription int,
 		return 0, err
 	}
 
-	a.logger.Printf("Waiting for private service connect endpoint for subscription %d and service %d in region %d to finish being created", subscription, pscServiceId, regionId)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for private service connect endpoint for subscription %d and service %d in region %d to finish being created", subscription, pscServiceId, regionId), internal.SubscriptionID(subscription), internal.ResourceID("psc_service", pscServiceId), internal.ResourceID("region", regionId))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -262,7 +262,7 @@ func (a *API) getService(ctx context.Context, message string, path string) (*Pri
 		return nil, err
 	}
 
-	a.logger.Printf("Waiting for private service connect request %d to complete", task.ID)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for private service connect request %s to complete", *task.ID), internal.TaskID(task.ID))
 
 	var response PrivateServiceConnectService
 	err = a.taskWaiter.WaitForResource(ctx, *task.ID, &response)
@@ -280,7 +280,7 @@ func (a *API) getEndpoints(ctx context.Context, message string, path string) (*P
 		return nil, err
 	}
 
-	a.logger.Printf("Waiting for private service connect request %d to complete", task.ID)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for private service connect request %s to complete", *task.ID), internal.TaskID(task.ID))
 
 	var response PrivateServiceConnectEndpoints
 	err = a.taskWaiter.WaitForResource(ctx, *task.ID, &response)
@@ -298,7 +298,7 @@ func (a *API) getCreationScript(ctx context.Context, message string, path string
 		return nil, err
 	}
 
-	a.logger.Printf("Waiting for private service connect creation script request %d to complete", task.ID)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for private service connect creation script request %s to complete", *task.ID), internal.TaskID(task.ID))
 
 	var response CreationScript
 	err = a.taskWaiter.WaitForResource(ctx, *task.ID, &response)
@@ -316,7 +316,7 @@ func (a *API) getDeletionScript(ctx context.Context, message string, path string
 		return nil, err
 	}
 
-	a.logger.Printf("Waiting for private service connect deletion script request %d to complete", task.ID)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for private service connect deletion script request %s to complete", *task.ID), internal.TaskID(task.ID))
 
 	var response DeletionScript
 	err = a.taskWaiter.WaitForResource(ctx, *task.ID, &response)
@@ -334,7 +334,7 @@ func (a *API) create(ctx context.Context, message string, path string) (int, err
 		return 0, err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish creating the Private Service Connect", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish creating the Private Service Connect", task), internal.TaskID(task.ID))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -351,7 +351,7 @@ func (a *API) update(ctx context.Context, message string, path string, body any)
 		return err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish updating the Private Service Connect", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish updating the Private Service Connect", task), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
@@ -368,7 +368,7 @@ func (a *API) delete(ctx context.Context, message string, path string) error {
 		return err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish deleting the Private Service Connect", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish deleting the Private Service Connect", task), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
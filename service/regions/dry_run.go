@@ -0,0 +1,29 @@
+package regions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+)
+
+// DryRunCreate checks whether the region could be added to the subscription, and what it would cost, without adding
+// it. The API refusing the request is reported by the result rather than as an error.
+func (a *API) DryRunCreate(ctx context.Context, subId int, region CreateRegion) (*pricing.DryRunResult, error) {
+	region.DryRun = redis.Bool(true)
+
+	var task internal.TaskResponse
+	err := a.client.Post(ctx, "dry run creating subscription region", fmt.Sprintf("/subscriptions/%d/regions", subId), region, &task)
+	if err != nil {
+		return nil, wrap404Error(subId, err)
+	}
+
+	var resource pricing.ListPricingResponse
+	refusal, err := internal.WaitForDryRun(ctx, a.taskWaiter.WaitForResource, *task.ID, &resource)
+	if err != nil {
+		return nil, err
+	}
+	return pricing.NewDryRunResult(refusal, resource), nil
+}
@@ -45,7 +45,7 @@ func (a *API) Create(ctx context.Context, subId int, region CreateRegion) (int,
 		return 0, wrap404Error(subId, err)
 	}
 
-	a.logger.Printf("Waiting for task %s to finish creating the subscription region", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish creating the subscription region", task), internal.TaskID(task.ID))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -73,7 +73,7 @@ func (a *API) DeleteWithQuery(ctx context.Context, id int, regions DeleteRegions
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for region %d to finish being deleted", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for region %d to finish being deleted", id), internal.SubscriptionID(id))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -0,0 +1,309 @@
+package subscriptions
+
+import (
+	"fmt"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+)
+
+const (
+	throughputOperationsPerSecond = "operations-per-second"
+	throughputNumberOfShards      = "number-of-shards"
+)
+
+// CreateBuilder builds a CreateSubscription one step at a time:
+//
+//	request, err := subscriptions.NewCreate("example").
+//		AWS(cloudAccountID).
+//		Region("us-east-1", "10.0.0.0/24").
+//		Database(subscriptions.NewDatabase("cache").MemoryLimitInGB(1).OperationsPerSecond(1000)).
+//		Build()
+//
+// Each step checks its own arguments as it's called, and Build checks the request as a whole. Every problem found
+// along the way is reported by Build as a single *rediscloud_api.ValidationError, so a chain never needs to be broken
+// up to check for errors.
+type CreateBuilder struct {
+	request    CreateSubscription
+	validation *internal.Validation
+}
+
+// NewCreate starts building a request to create a subscription with the given name.
+func NewCreate(name string) *CreateBuilder {
+	return &CreateBuilder{
+		request:    CreateSubscription{Name: redis.String(name)},
+		validation: internal.NewValidation("create subscription request"),
+	}
+}
+
+// DryRun makes the request only check the subscription can be created, and what it would cost, without creating it.
+func (b *CreateBuilder) DryRun() *CreateBuilder {
+	b.request.DryRun = redis.Bool(true)
+	return b
+}
+
+// ActiveActive makes the subscription an Active-Active one. Every region then needs its own deployment CIDR.
+func (b *CreateBuilder) ActiveActive() *CreateBuilder {
+	b.request.DeploymentType = redis.Ptr(SubscriptionDeploymentTypeActiveActive)
+	return b
+}
+
+// PaymentMethodID bills the subscription to the given credit card.
+func (b *CreateBuilder) PaymentMethodID(id int) *CreateBuilder {
+	b.request.PaymentMethod = redis.String(PaymentMethodCreditCard)
+	b.request.PaymentMethodID = redis.Int(id)
+	return b
+}
+
+// Marketplace bills the subscription through the cloud marketplace account.
+func (b *CreateBuilder) Marketplace() *CreateBuilder {
+	b.request.PaymentMethod = redis.String(PaymentMethodMarketplace)
+	b.request.PaymentMethodID = nil
+	return b
+}
+
+// MemoryStorage sets the type of memory used by the subscription's databases, one of MemoryStorageValues.
+func (b *CreateBuilder) MemoryStorage(storage string) *CreateBuilder {
+	b.request.MemoryStorage = redis.String(storage)
+	internal.OneOf(b.validation, "memoryStorage", b.request.MemoryStorage, databases.MemoryStorageValues())
+	return b
+}
+
+// RedisVersion sets the version of Redis used by the subscription's databases.
+func (b *CreateBuilder) RedisVersion(version string) *CreateBuilder {
+	b.request.RedisVersion = redis.String(version)
+	return b
+}
+
+// PublicEndpointAccess sets whether the subscription's databases can be reached through their public endpoints.
+func (b *CreateBuilder) PublicEndpointAccess(enabled bool) *CreateBuilder {
+	b.request.PublicEndpointAccess = redis.Bool(enabled)
+	return b
+}
+
+// AWS deploys the following regions to AWS, using the given cloud account.
+func (b *CreateBuilder) AWS(cloudAccountID int) *CreateBuilder {
+	return b.provider("AWS", redis.Int(cloudAccountID))
+}
+
+// GCP deploys the following regions to GCP.
+func (b *CreateBuilder) GCP() *CreateBuilder {
+	return b.provider("GCP", nil)
+}
+
+func (b *CreateBuilder) provider(provider string, cloudAccountID *int) *CreateBuilder {
+	b.request.CloudProviders = append(b.request.CloudProviders, &CreateCloudProvider{
+		Provider:       redis.String(provider),
+		CloudAccountID: cloudAccountID,
+	})
+	return b
+}
+
+// Tag adds a resource tag to the cloud provider chosen last.
+func (b *CreateBuilder) Tag(key, value string) *CreateBuilder {
+	provider, _ := b.lastProvider("Tag")
+	if provider != nil {
+		provider.ResourceTags = append(provider.ResourceTags, &ResourceTag{Key: redis.String(key), Value: redis.String(value)})
+	}
+	return b
+}
+
+// Region deploys the subscription to a region of the cloud provider chosen last, using the given deployment CIDR.
+func (b *CreateBuilder) Region(region, deploymentCIDR string) *CreateBuilder {
+	provider, field := b.lastProvider("Region")
+	if provider == nil {
+		return b
+	}
+	field = fmt.Sprintf("%s.regions[%d]", field, len(provider.Regions))
+	provider.Regions = append(provider.Regions, &CreateRegion{
+		Region:     redis.String(region),
+		Networking: &CreateNetworking{DeploymentCIDR: redis.String(deploymentCIDR)},
+	})
+	b.validation.CIDR(field+".networking.deploymentCIDR", redis.String(deploymentCIDR))
+	return b
+}
+
+// MultipleAvailabilityZones spreads the region added last across availability zones, preferring the ones given.
+func (b *CreateBuilder) MultipleAvailabilityZones(preferredZones ...string) *CreateBuilder {
+	if region := b.lastRegion("MultipleAvailabilityZones"); region != nil {
+		region.MultipleAvailabilityZones = redis.Bool(true)
+		region.PreferredAvailabilityZones = redis.StringSlice(preferredZones...)
+	}
+	return b
+}
+
+// VPC deploys the region added last into an existing VPC.
+func (b *CreateBuilder) VPC(vpcID string) *CreateBuilder {
+	if region := b.lastRegion("VPC"); region != nil {
+		region.Networking.VPCId = redis.String(vpcID)
+	}
+	return b
+}
+
+// Database adds a database to the subscription. Any problems found while building it are reported by Build.
+func (b *CreateBuilder) Database(database *DatabaseBuilder) *CreateBuilder {
+	field := fmt.Sprintf("databases[%d]", len(b.request.Databases))
+	request, err := database.Build()
+	b.validation.Merge(field, err)
+	b.request.Databases = append(b.request.Databases, request)
+	return b
+}
+
+// Build returns the request, or a *rediscloud_api.ValidationError listing every problem found while building it.
+func (b *CreateBuilder) Build() (*CreateSubscription, error) {
+	request := b.request
+	b.validation.Merge("", request.Validate())
+	if err := b.validation.Err(); err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (b *CreateBuilder) lastProvider(step string) (*CreateCloudProvider, string) {
+	if len(b.request.CloudProviders) == 0 {
+		b.validation.Addf("%s needs a cloud provider to be chosen first", step)
+		return nil, ""
+	}
+	i := len(b.request.CloudProviders) - 1
+	return b.request.CloudProviders[i], fmt.Sprintf("cloudProviders[%d]", i)
+}
+
+func (b *CreateBuilder) lastRegion(step string) *CreateRegion {
+	provider, _ := b.lastProvider(step)
+	if provider == nil {
+		return nil
+	}
+	if len(provider.Regions) == 0 {
+		b.validation.Addf("%s needs a region to be added first", step)
+		return nil
+	}
+	return provider.Regions[len(provider.Regions)-1]
+}
+
+// DatabaseBuilder builds one of the databases created along with a subscription. See CreateBuilder.
+type DatabaseBuilder struct {
+	request    CreateDatabase
+	validation *internal.Validation
+}
+
+// NewDatabase starts building a database with the given name.
+func NewDatabase(name string) *DatabaseBuilder {
+	return &DatabaseBuilder{
+		request:    CreateDatabase{Name: redis.String(name)},
+		validation: internal.NewValidation("create database request"),
+	}
+}
+
+// Protocol sets the protocol of the database, one of ProtocolValues.
+func (b *DatabaseBuilder) Protocol(protocol string) *DatabaseBuilder {
+	b.request.Protocol = redis.String(protocol)
+	internal.OneOf(b.validation, "protocol", b.request.Protocol, databases.ProtocolValues())
+	return b
+}
+
+// MemoryLimitInGB sets the memory limit of the database, replacing any dataset size.
+func (b *DatabaseBuilder) MemoryLimitInGB(gb float64) *DatabaseBuilder {
+	b.request.MemoryLimitInGB = redis.Float64(gb)
+	b.request.DatasetSizeInGB = nil
+	internal.Positive(b.validation, "memoryLimitInGb", b.request.MemoryLimitInGB)
+	return b
+}
+
+// DatasetSizeInGB sets the maximum size of the dataset, which doesn't include replication, replacing any memory limit.
+func (b *DatabaseBuilder) DatasetSizeInGB(gb float64) *DatabaseBuilder {
+	b.request.DatasetSizeInGB = redis.Float64(gb)
+	b.request.MemoryLimitInGB = nil
+	internal.Positive(b.validation, "datasetSizeInGb", b.request.DatasetSizeInGB)
+	return b
+}
+
+// Persistence sets how the data is persisted.
+func (b *DatabaseBuilder) Persistence(persistence databases.DataPersistence) *DatabaseBuilder {
+	b.request.DataPersistence = redis.Ptr(persistence)
+	internal.OneOf(b.validation, "dataPersistence", b.request.DataPersistence, databases.DataPersistenceValues())
+	return b
+}
+
+// Replication sets whether the database is replicated.
+func (b *DatabaseBuilder) Replication(enabled bool) *DatabaseBuilder {
+	b.request.Replication = redis.Bool(enabled)
+	return b
+}
+
+// SupportOSSClusterAPI sets whether the database can be used with the OSS Cluster API.
+func (b *DatabaseBuilder) SupportOSSClusterAPI(enabled bool) *DatabaseBuilder {
+	b.request.SupportOSSClusterAPI = redis.Bool(enabled)
+	return b
+}
+
+// OperationsPerSecond sizes the database by the throughput it needs.
+func (b *DatabaseBuilder) OperationsPerSecond(ops int) *DatabaseBuilder {
+	return b.throughput(throughputOperationsPerSecond, ops)
+}
+
+// NumberOfShards sizes the database by the number of shards it needs.
+func (b *DatabaseBuilder) NumberOfShards(shards int) *DatabaseBuilder {
+	return b.throughput(throughputNumberOfShards, shards)
+}
+
+func (b *DatabaseBuilder) throughput(by string, value int) *DatabaseBuilder {
+	b.request.ThroughputMeasurement = &CreateThroughput{By: redis.String(by), Value: redis.Int(value)}
+	internal.Positive(b.validation, "throughputMeasurement.value", b.request.ThroughputMeasurement.Value)
+	return b
+}
+
+// LocalThroughput sets the throughput of the database in one of the regions of an Active-Active subscription.
+func (b *DatabaseBuilder) LocalThroughput(region string, writeOps, readOps int) *DatabaseBuilder {
+	field := fmt.Sprintf("localThroughputMeasurement[%d]", len(b.request.LocalThroughputMeasurement))
+	b.request.LocalThroughputMeasurement = append(b.request.LocalThroughputMeasurement, &CreateLocalThroughput{
+		Region:                   redis.String(region),
+		WriteOperationsPerSecond: redis.Int(writeOps),
+		ReadOperationsPerSecond:  redis.Int(readOps),
+	})
+	internal.Positive(b.validation, field+".writeOperationsPerSecond", redis.Int(writeOps))
+	internal.Positive(b.validation, field+".readOperationsPerSecond", redis.Int(readOps))
+	return b
+}
+
+// Modules adds Redis modules to the database.
+func (b *DatabaseBuilder) Modules(names ...string) *DatabaseBuilder {
+	for _, name := range names {
+		b.request.Modules = append(b.request.Modules, &CreateModules{Name: redis.String(name)})
+	}
+	return b
+}
+
+// Quantity sets how many databases like this one are used to size the subscription.
+func (b *DatabaseBuilder) Quantity(quantity int) *DatabaseBuilder {
+	b.request.Quantity = redis.Int(quantity)
+	internal.Positive(b.validation, "quantity", b.request.Quantity)
+	return b
+}
+
+// AverageItemSizeInBytes sets the expected average item size, used to size Redis on Flash subscriptions.
+func (b *DatabaseBuilder) AverageItemSizeInBytes(size int) *DatabaseBuilder {
+	b.request.AverageItemSizeInBytes = redis.Int(size)
+	internal.Positive(b.validation, "averageItemSizeInBytes", b.request.AverageItemSizeInBytes)
+	return b
+}
+
+// RamPercentage sets the percentage of the data kept in RAM, for Redis on Flash subscriptions.
+func (b *DatabaseBuilder) RamPercentage(percentage int) *DatabaseBuilder {
+	b.request.RamPercentage = redis.Int(percentage)
+	internal.InRange(b.validation, "ramPercentage", b.request.RamPercentage, 0, 100)
+	return b
+}
+
+// QueryPerformanceFactor sets the query performance factor of a database using the search and query capabilities.
+func (b *DatabaseBuilder) QueryPerformanceFactor(factor string) *DatabaseBuilder {
+	b.request.QueryPerformanceFactor = redis.String(factor)
+	return b
+}
+
+// Build returns the database, or a *rediscloud_api.ValidationError listing every problem found while building it.
+// The database is also returned alongside an error, so that CreateBuilder can carry on building the subscription.
+func (b *DatabaseBuilder) Build() (*CreateDatabase, error) {
+	request := b.request
+	return &request, b.validation.Err()
+}
@@ -0,0 +1,89 @@
+package subscriptions
+
+import (
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBuilder_Build(t *testing.T) {
+	actual, err := NewCreate("example").
+		PaymentMethodID(2).
+		MemoryStorage(databases.MemoryStorageRam).
+		AWS(3).
+		Tag("team", "cache").
+		Region("us-east-1", "10.0.0.0/24").
+		MultipleAvailabilityZones("use1-az1", "use1-az2").
+		Database(NewDatabase("cache").
+			DatasetSizeInGB(5).
+			MemoryLimitInGB(1).
+			Persistence(databases.DataPersistenceNone).
+			Replication(true).
+			OperationsPerSecond(1000).
+			Modules("RedisJSON").
+			Quantity(2)).
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, &CreateSubscription{
+		Name:            redis.String("example"),
+		PaymentMethod:   redis.String(PaymentMethodCreditCard),
+		PaymentMethodID: redis.Int(2),
+		MemoryStorage:   redis.String(databases.MemoryStorageRam),
+		CloudProviders: []*CreateCloudProvider{
+			{
+				Provider:       redis.String("AWS"),
+				CloudAccountID: redis.Int(3),
+				ResourceTags:   []*ResourceTag{{Key: redis.String("team"), Value: redis.String("cache")}},
+				Regions: []*CreateRegion{
+					{
+						Region:                     redis.String("us-east-1"),
+						MultipleAvailabilityZones:  redis.Bool(true),
+						PreferredAvailabilityZones: redis.StringSlice("use1-az1", "use1-az2"),
+						Networking:                 &CreateNetworking{DeploymentCIDR: redis.String("10.0.0.0/24")},
+					},
+				},
+			},
+		},
+		Databases: []*CreateDatabase{
+			{
+				Name:                  redis.String("cache"),
+				MemoryLimitInGB:       redis.Float64(1),
+				DataPersistence:       redis.Ptr(databases.DataPersistenceNone),
+				Replication:           redis.Bool(true),
+				ThroughputMeasurement: &CreateThroughput{By: redis.String("operations-per-second"), Value: redis.Int(1000)},
+				Modules:               []*CreateModules{{Name: redis.String("RedisJSON")}},
+				Quantity:              redis.Int(2),
+			},
+		},
+	}, actual)
+}
+
+func TestCreateBuilder_reportsEveryProblem(t *testing.T) {
+	_, err := NewCreate("example").
+		ActiveActive().
+		VPC("vpc-1").
+		GCP().
+		Region("us-east1", "not-a-cidr").
+		Database(NewDatabase("cache").MemoryLimitInGB(-1).Persistence("sometimes")).
+		Build()
+
+	var validationErr *internal.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []string{
+		"VPC needs a cloud provider to be chosen first",
+		`cloudProviders[0].regions[0].networking.deploymentCIDR must be a CIDR block, got "not-a-cidr"`,
+		"databases[0].memoryLimitInGb must be greater than 0, got -1",
+		`databases[0].dataPersistence must be one of none, aof-every-1-second, aof-every-write, snapshot-every-1-hour, snapshot-every-6-hours, snapshot-every-12-hours, got "sometimes"`,
+	}, validationErr.Problems)
+}
+
+func TestCreateBuilder_Build_checksWholeRequest(t *testing.T) {
+	_, err := NewCreate("example").AWS(1).Region("us-east-1", "10.0.0.0/24").Build()
+
+	assert.EqualError(t, err, "invalid create subscription request: databases is required")
+}
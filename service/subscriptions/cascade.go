@@ -0,0 +1,488 @@
+package subscriptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/privatelink"
+	"github.com/RedisLabs/rediscloud-go-api/service/psc"
+	"github.com/RedisLabs/rediscloud-go-api/service/transit_gateway/attachments"
+)
+
+// ErrNotConfirmed is returned by DeleteCascade when the confirmation guard doesn't match the subscription.
+var ErrNotConfirmed = errors.New("subscription deletion was not confirmed")
+
+// CascadeDatabases is the part of the databases API used by DeleteCascade.
+type CascadeDatabases interface {
+	List(ctx context.Context, subscription int) *databases.ListDatabase
+	ListActiveActive(ctx context.Context, subscription int) *databases.ListActiveActiveDatabase
+	Delete(ctx context.Context, subscription int, database int) error
+}
+
+// CascadeTransitGateways is the part of the Transit Gateway attachments API used by DeleteCascade.
+type CascadeTransitGateways interface {
+	Get(ctx context.Context, subscription int) (*attachments.GetAttachmentsTask, error)
+	GetActiveActive(ctx context.Context, subscription int, regionId int) (*attachments.GetAttachmentsTask, error)
+	Delete(ctx context.Context, subscription int, tgwId int) error
+	DeleteActiveActive(ctx context.Context, subscription int, regionId int, tgwId int) error
+}
+
+// CascadePrivateServiceConnect is the part of the Private Service Connect API used by DeleteCascade.
+type CascadePrivateServiceConnect interface {
+	GetService(ctx context.Context, subscription int) (*psc.PrivateServiceConnectService, error)
+	GetActiveActiveService(ctx context.Context, subscription int, regionId int) (*psc.PrivateServiceConnectService, error)
+	GetEndpoints(ctx context.Context, subscription int, pscServiceId int) (*psc.PrivateServiceConnectEndpoints, error)
+	GetActiveActiveEndpoints(ctx context.Context, subscription int, regionId int, pscServiceId int) (*psc.PrivateServiceConnectEndpoints, error)
+	DeleteService(ctx context.Context, subscription int) error
+	DeleteActiveActiveService(ctx context.Context, subscription int, regionId int) error
+	DeleteEndpoint(ctx context.Context, subscription int, pscServiceId int, endpointId int) error
+	DeleteActiveActiveEndpoint(ctx context.Context, subscription int, regionId int, pscServiceId int, endpointId int) error
+}
+
+// CascadePrivateLink is the part of the PrivateLink API used by DeleteCascade.
+type CascadePrivateLink interface {
+	GetPrivateLink(ctx context.Context, subscription int) (*privatelink.PrivateLink, error)
+	GetActiveActivePrivateLink(ctx context.Context, subscription int, regionId int) (*privatelink.PrivateLink, error)
+	DeletePrivateLink(ctx context.Context, subscriptionId int) error
+	DeleteActiveActivePrivateLink(ctx context.Context, subscriptionId int, regionId int) error
+}
+
+// CascadeServices are the services DeleteCascade uses to find and delete the resources within a subscription. Any
+// service left nil is skipped.
+type CascadeServices struct {
+	Databases             CascadeDatabases
+	TransitGateways       CascadeTransitGateways
+	PrivateServiceConnect CascadePrivateServiceConnect
+	PrivateLink           CascadePrivateLink
+}
+
+// SetCascadeServices provides the services used by DeleteCascade - the client does this automatically.
+func (a *API) SetCascadeServices(services CascadeServices) {
+	a.cascade = services
+}
+
+// DeleteCascadeOptions control how DeleteCascade tears a subscription down.
+type DeleteCascadeOptions struct {
+	// DryRun only plans the deletion: the plan is returned, but nothing is deleted.
+	DryRun bool
+	// Confirm must be set to the name of the subscription (or its ID, when it has no name) for anything to be deleted,
+	// guarding against deleting the wrong subscription by mistake. It isn't required for a dry run.
+	Confirm string
+}
+
+// Confirms returns ErrNotConfirmed unless Confirm guards the deletion of the subscription with the given ID and name.
+func (o DeleteCascadeOptions) Confirms(id int, name *string) error {
+	expected := redis.StringValue(name)
+	if expected == "" {
+		expected = strconv.Itoa(id)
+	}
+	if o.Confirm != expected {
+		return fmt.Errorf("%w: confirm with %q", ErrNotConfirmed, expected)
+	}
+	return nil
+}
+
+// DeletionPlan lists the resources deleted by DeleteCascade, in the order they're deleted.
+type DeletionPlan struct {
+	SubscriptionID int
+	Steps          []string
+}
+
+func (o DeletionPlan) String() string {
+	lines := make([]string, 0, len(o.Steps)+1)
+	lines = append(lines, fmt.Sprintf("Deleting subscription %d will:", o.SubscriptionID))
+	for i, step := range o.Steps {
+		lines = append(lines, fmt.Sprintf("%d. %s", i+1, step))
+	}
+	return strings.Join(lines, "\n")
+}
+
+type cascadeStep struct {
+	description string
+	run         func(ctx context.Context) error
+}
+
+// DeleteCascade deletes a subscription along with everything within it: Private Service Connect endpoints and
+// services, PrivateLink, Transit Gateway attachments, VPC peerings and databases are deleted (in that order) before
+// the subscription itself.
+//
+// The returned plan lists every step; when a step fails, the plan is returned alongside the error so the caller can
+// tell how far the teardown got.
+func (a *API) DeleteCascade(ctx context.Context, id int, options DeleteCascadeOptions) (*DeletionPlan, error) {
+	subscription, err := a.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, err := a.planCascade(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &DeletionPlan{SubscriptionID: id}
+	for _, step := range steps {
+		plan.Steps = append(plan.Steps, step.description)
+	}
+
+	if options.DryRun {
+		return plan, nil
+	}
+
+	if err := options.Confirms(id, subscription.Name); err != nil {
+		return plan, err
+	}
+
+	for i, step := range steps {
+		internal.LogInfo(ctx, a.logger, fmt.Sprintf("Subscription %d teardown step %d/%d: %s", id, i+1, len(steps), step.description), internal.SubscriptionID(id), slog.Int("step", i+1))
+		if err := step.run(ctx); err != nil {
+			return plan, fmt.Errorf("failed to %s: %w", step.description, err)
+		}
+	}
+
+	return plan, nil
+}
+
+func (a *API) planCascade(ctx context.Context, subscription *Subscription) ([]*cascadeStep, error) {
+	id := redis.IntValue(subscription.ID)
+	activeActive := redis.Value(subscription.DeploymentType) == SubscriptionDeploymentTypeActiveActive
+
+	// Active-Active networking resources are per region, single region ones are addressed by the subscription alone.
+	// The planners tell them apart by regionIds being nil, so it's never nil for Active-Active, even without regions.
+	var regionIds []int
+	if activeActive {
+		regions, err := a.ListActiveActiveRegions(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		regionIds = make([]int, 0, len(regions))
+		for _, region := range regions {
+			regionIds = append(regionIds, redis.IntValue(region.RegionId))
+		}
+	}
+
+	var steps []*cascadeStep
+	for _, planner := range []func(context.Context, *Subscription, []int) ([]*cascadeStep, error){
+		a.planPrivateServiceConnect,
+		a.planPrivateLink,
+		a.planTransitGateways,
+		a.planVPCPeerings,
+		a.planDatabases,
+	} {
+		planned, err := planner(ctx, subscription, regionIds)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, planned...)
+	}
+
+	return append(steps, &cascadeStep{
+		description: fmt.Sprintf("delete subscription %d", id),
+		run: func(ctx context.Context) error {
+			return a.Delete(ctx, id)
+		},
+	}), nil
+}
+
+func (a *API) planPrivateServiceConnect(ctx context.Context, subscription *Subscription, regionIds []int) ([]*cascadeStep, error) {
+	services := a.cascade.PrivateServiceConnect
+	if services == nil || !subscription.usesProvider("GCP") {
+		return nil, nil
+	}
+	id := redis.IntValue(subscription.ID)
+
+	var steps []*cascadeStep
+	if regionIds == nil {
+		service, err := services.GetService(ctx, id)
+		if isNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		serviceId := redis.IntValue(service.ID)
+		endpoints, err := services.GetEndpoints(ctx, id, serviceId)
+		if err != nil && !isNotFound(err) {
+			return nil, err
+		}
+		for _, endpoint := range pscEndpoints(endpoints) {
+			endpointId := redis.IntValue(endpoint.ID)
+			steps = append(steps, &cascadeStep{
+				description: fmt.Sprintf("delete Private Service Connect endpoint %d", endpointId),
+				run: func(ctx context.Context) error {
+					return services.DeleteEndpoint(ctx, id, serviceId, endpointId)
+				},
+			})
+		}
+		return append(steps, &cascadeStep{
+			description: fmt.Sprintf("delete Private Service Connect service %d", serviceId),
+			run: func(ctx context.Context) error {
+				return services.DeleteService(ctx, id)
+			},
+		}), nil
+	}
+
+	for _, regionId := range regionIds {
+		service, err := services.GetActiveActiveService(ctx, id, regionId)
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		serviceId := redis.IntValue(service.ID)
+		endpoints, err := services.GetActiveActiveEndpoints(ctx, id, regionId, serviceId)
+		if err != nil && !isNotFound(err) {
+			return nil, err
+		}
+		for _, endpoint := range pscEndpoints(endpoints) {
+			endpointId := redis.IntValue(endpoint.ID)
+			steps = append(steps, &cascadeStep{
+				description: fmt.Sprintf("delete Private Service Connect endpoint %d in region %d", endpointId, regionId),
+				run: func(ctx context.Context) error {
+					return services.DeleteActiveActiveEndpoint(ctx, id, regionId, serviceId, endpointId)
+				},
+			})
+		}
+		steps = append(steps, &cascadeStep{
+			description: fmt.Sprintf("delete Private Service Connect service %d in region %d", serviceId, regionId),
+			run: func(ctx context.Context) error {
+				return services.DeleteActiveActiveService(ctx, id, regionId)
+			},
+		})
+	}
+	return steps, nil
+}
+
+func (a *API) planPrivateLink(ctx context.Context, subscription *Subscription, regionIds []int) ([]*cascadeStep, error) {
+	links := a.cascade.PrivateLink
+	if links == nil || !subscription.usesProvider("AWS") {
+		return nil, nil
+	}
+	id := redis.IntValue(subscription.ID)
+
+	if regionIds == nil {
+		link, err := links.GetPrivateLink(ctx, id)
+		if isNotFound(err) || (err == nil && isDeletedPrivateLink(link)) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []*cascadeStep{{
+			description: "delete PrivateLink " + redis.StringValue(link.ShareName),
+			run: func(ctx context.Context) error {
+				return links.DeletePrivateLink(ctx, id)
+			},
+		}}, nil
+	}
+
+	var steps []*cascadeStep
+	for _, regionId := range regionIds {
+		link, err := links.GetActiveActivePrivateLink(ctx, id, regionId)
+		if isNotFound(err) || (err == nil && isDeletedPrivateLink(link)) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, &cascadeStep{
+			description: fmt.Sprintf("delete PrivateLink %s in region %d", redis.StringValue(link.ShareName), regionId),
+			run: func(ctx context.Context) error {
+				return links.DeleteActiveActivePrivateLink(ctx, id, regionId)
+			},
+		})
+	}
+	return steps, nil
+}
+
+func (a *API) planTransitGateways(ctx context.Context, subscription *Subscription, regionIds []int) ([]*cascadeStep, error) {
+	gateways := a.cascade.TransitGateways
+	if gateways == nil || !subscription.usesProvider("AWS") {
+		return nil, nil
+	}
+	id := redis.IntValue(subscription.ID)
+
+	if regionIds == nil {
+		task, err := gateways.Get(ctx, id)
+		if isNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var steps []*cascadeStep
+		for _, tgwId := range attachedGateways(task) {
+			steps = append(steps, &cascadeStep{
+				description: fmt.Sprintf("delete Transit Gateway attachment to %d", tgwId),
+				run: func(ctx context.Context) error {
+					return gateways.Delete(ctx, id, tgwId)
+				},
+			})
+		}
+		return steps, nil
+	}
+
+	var steps []*cascadeStep
+	for _, regionId := range regionIds {
+		task, err := gateways.GetActiveActive(ctx, id, regionId)
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, tgwId := range attachedGateways(task) {
+			steps = append(steps, &cascadeStep{
+				description: fmt.Sprintf("delete Transit Gateway attachment to %d in region %d", tgwId, regionId),
+				run: func(ctx context.Context) error {
+					return gateways.DeleteActiveActive(ctx, id, regionId, tgwId)
+				},
+			})
+		}
+	}
+	return steps, nil
+}
+
+func (a *API) planVPCPeerings(ctx context.Context, subscription *Subscription, regionIds []int) ([]*cascadeStep, error) {
+	id := redis.IntValue(subscription.ID)
+
+	var steps []*cascadeStep
+	if regionIds == nil {
+		peerings, err := a.ListVPCPeering(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, peering := range peerings {
+			peeringId := redis.IntValue(peering.ID)
+			steps = append(steps, &cascadeStep{
+				description: fmt.Sprintf("delete VPC peering %d", peeringId),
+				run: func(ctx context.Context) error {
+					return a.DeleteVPCPeering(ctx, id, peeringId)
+				},
+			})
+		}
+		return steps, nil
+	}
+
+	regions, err := a.ListActiveActiveVPCPeering(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, region := range regions {
+		for _, peering := range region.VPCPeerings {
+			peeringId := redis.IntValue(peering.ID)
+			steps = append(steps, &cascadeStep{
+				description: fmt.Sprintf("delete VPC peering %d in region %s", peeringId, redis.StringValue(region.SourceRegion)),
+				run: func(ctx context.Context) error {
+					return a.DeleteActiveActiveVPCPeering(ctx, id, peeringId)
+				},
+			})
+		}
+	}
+	return steps, nil
+}
+
+func (a *API) planDatabases(ctx context.Context, subscription *Subscription, regionIds []int) ([]*cascadeStep, error) {
+	dbs := a.cascade.Databases
+	if dbs == nil {
+		return nil, nil
+	}
+	id := redis.IntValue(subscription.ID)
+
+	type database struct {
+		id   int
+		name string
+	}
+	var found []database
+	if regionIds == nil {
+		list := dbs.List(ctx, id)
+		for list.Next() {
+			found = append(found, database{redis.IntValue(list.Value().ID), redis.StringValue(list.Value().Name)})
+		}
+		if list.Err() != nil {
+			return nil, list.Err()
+		}
+	} else {
+		list := dbs.ListActiveActive(ctx, id)
+		for list.Next() {
+			found = append(found, database{redis.IntValue(list.Value().ID), redis.StringValue(list.Value().Name)})
+		}
+		if list.Err() != nil {
+			return nil, list.Err()
+		}
+	}
+
+	steps := make([]*cascadeStep, 0, len(found))
+	for _, db := range found {
+		steps = append(steps, &cascadeStep{
+			description: fmt.Sprintf("delete database %d (%s)", db.id, db.name),
+			run: func(ctx context.Context) error {
+				return dbs.Delete(ctx, id, db.id)
+			},
+		})
+	}
+	return steps, nil
+}
+
+func (o Subscription) usesProvider(provider string) bool {
+	for _, detail := range o.CloudDetails {
+		if detail != nil && strings.EqualFold(redis.StringValue(detail.Provider), provider) {
+			return true
+		}
+	}
+	// Without any cloud details, it's safer to look for the resource than to skip it
+	return len(o.CloudDetails) == 0
+}
+
+func pscEndpoints(endpoints *psc.PrivateServiceConnectEndpoints) []*psc.PrivateServiceConnectEndpoint {
+	if endpoints == nil {
+		return nil
+	}
+	var ret []*psc.PrivateServiceConnectEndpoint
+	for _, endpoint := range endpoints.Endpoints {
+		if endpoint != nil && redis.Value(endpoint.Status) != psc.EndpointStatusDeleted {
+			ret = append(ret, endpoint)
+		}
+	}
+	return ret
+}
+
+func isDeletedPrivateLink(link *privatelink.PrivateLink) bool {
+	return link == nil || redis.Value(link.Status) == privatelink.PrivateLinkStatusDeleted
+}
+
+func attachedGateways(task *attachments.GetAttachmentsTask) []int {
+	if task == nil || task.Response == nil || task.Response.Resource == nil {
+		return nil
+	}
+	var ret []int
+	for _, tgw := range task.Response.Resource.TransitGatewayAttachment {
+		// Gateways which have been shared with the subscription but never attached have no attachment
+		if tgw != nil && tgw.AttachmentUid != nil {
+			ret = append(ret, redis.IntValue(tgw.Id))
+		}
+	}
+	return ret
+}
+
+func isNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	var pscNotFound *psc.NotFound
+	var pscNotFoundAA *psc.NotFoundActiveActive
+	var linkNotFound *privatelink.NotFound
+	var linkNotFoundAA *privatelink.NotFoundActiveActive
+	var tgwNotFound *attachments.NotFound
+	var tgwNotFoundAA *attachments.NotFoundActiveActive
+	return errors.As(err, &pscNotFound) || errors.As(err, &pscNotFoundAA) ||
+		errors.As(err, &linkNotFound) || errors.As(err, &linkNotFoundAA) ||
+		errors.As(err, &tgwNotFound) || errors.As(err, &tgwNotFoundAA)
+}
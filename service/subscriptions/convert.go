@@ -0,0 +1,68 @@
+package subscriptions
+
+// ToCreate converts the subscription into a CreateSubscription request with the same cloud provider, region and
+// networking configuration, e.g. to recreate it in another account.
+//
+// The creation plan of a subscription (its `databases`) is never returned by the API, so the second return value
+// lists the JSON names of the fields the caller must supply themselves before the request can be sent.
+func (o Subscription) ToCreate() (CreateSubscription, []string) {
+	missing := []string{"databases"}
+
+	create := CreateSubscription{
+		Name:                            o.Name,
+		DeploymentType:                  o.DeploymentType,
+		PaymentMethodID:                 o.PaymentMethodID,
+		PaymentMethod:                   o.PaymentMethod,
+		MemoryStorage:                   o.MemoryStorage,
+		PersistentStorageEncryptionType: o.PersistentStorageEncryptionType,
+		PublicEndpointAccess:            o.PublicEndpointAccess,
+	}
+
+	for _, detail := range o.CloudDetails {
+		if detail == nil {
+			continue
+		}
+		provider := &CreateCloudProvider{
+			Provider:       detail.Provider,
+			CloudAccountID: detail.CloudAccountID,
+		}
+		for _, tag := range detail.ResourceTags {
+			if tag != nil {
+				provider.ResourceTags = append(provider.ResourceTags, &ResourceTag{Key: tag.Key, Value: tag.Value})
+			}
+		}
+		for _, region := range detail.Regions {
+			if region == nil {
+				continue
+			}
+			createRegion := &CreateRegion{
+				Region:                     region.Region,
+				MultipleAvailabilityZones:  region.MultipleAvailabilityZones,
+				PreferredAvailabilityZones: region.PreferredAvailabilityZones,
+			}
+			// Each availability zone has its own subnet, but they all share the same deployment CIDR and VPC
+			for _, networking := range region.Networking {
+				if networking != nil {
+					createRegion.Networking = &CreateNetworking{
+						DeploymentCIDR: networking.DeploymentCIDR,
+						VPCId:          networking.VPCId,
+					}
+					break
+				}
+			}
+			provider.Regions = append(provider.Regions, createRegion)
+		}
+		create.CloudProviders = append(create.CloudProviders, provider)
+	}
+
+	return create, missing
+}
+
+// ToUpdate converts the subscription into an UpdateSubscription request that re-applies its current settings.
+func (o Subscription) ToUpdate() UpdateSubscription {
+	return UpdateSubscription{
+		Name:                 o.Name,
+		PaymentMethodID:      o.PaymentMethodID,
+		PublicEndpointAccess: o.PublicEndpointAccess,
+	}
+}
@@ -0,0 +1,69 @@
+package subscriptions
+
+import (
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscription_ToCreate(t *testing.T) {
+	subscription := Subscription{
+		ID:              redis.Int(1),
+		Name:            redis.String("example"),
+		Status:          redis.Ptr(SubscriptionStatusActive),
+		DeploymentType:  redis.Ptr(SubscriptionDeploymentTypeSingleRegion),
+		PaymentMethodID: redis.Int(2),
+		MemoryStorage:   redis.String("ram"),
+		CloudDetails: []*CloudDetail{
+			{
+				Provider:       redis.String("AWS"),
+				CloudAccountID: redis.Int(3),
+				TotalSizeInGB:  redis.Float64(5),
+				Regions: []*Region{
+					{
+						Region: redis.String("us-east-1"),
+						Networking: []*Networking{
+							{
+								DeploymentCIDR: redis.String("10.0.0.0/24"),
+								VPCId:          redis.String("vpc-1"),
+								SubnetID:       redis.String("subnet-1"),
+							},
+						},
+						PreferredAvailabilityZones: redis.StringSlice("use1-az1"),
+						MultipleAvailabilityZones:  redis.Bool(false),
+					},
+				},
+				ResourceTags: []*ResourceTag{{Key: redis.String("team"), Value: redis.String("cache")}},
+			},
+		},
+	}
+
+	create, missing := subscription.ToCreate()
+
+	assert.Equal(t, CreateSubscription{
+		Name:            redis.String("example"),
+		DeploymentType:  redis.Ptr(SubscriptionDeploymentTypeSingleRegion),
+		PaymentMethodID: redis.Int(2),
+		MemoryStorage:   redis.String("ram"),
+		CloudProviders: []*CreateCloudProvider{
+			{
+				Provider:       redis.String("AWS"),
+				CloudAccountID: redis.Int(3),
+				Regions: []*CreateRegion{
+					{
+						Region:                     redis.String("us-east-1"),
+						MultipleAvailabilityZones:  redis.Bool(false),
+						PreferredAvailabilityZones: redis.StringSlice("use1-az1"),
+						Networking: &CreateNetworking{
+							DeploymentCIDR: redis.String("10.0.0.0/24"),
+							VPCId:          redis.String("vpc-1"),
+						},
+					},
+				},
+				ResourceTags: []*ResourceTag{{Key: redis.String("team"), Value: redis.String("cache")}},
+			},
+		},
+	}, create)
+	assert.Equal(t, []string{"databases"}, missing)
+}
@@ -0,0 +1,45 @@
+package subscriptions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/pricing"
+)
+
+// DryRunCreate checks whether the subscription could be created, and what it would cost, without creating it. The API
+// refusing the request is reported by the result rather than as an error.
+func (a *API) DryRunCreate(ctx context.Context, subscription CreateSubscription) (*pricing.DryRunResult, error) {
+	subscription.DryRun = redis.Bool(true)
+
+	var task internal.TaskResponse
+	err := a.client.Post(ctx, "dry run creating subscription", "/subscriptions", subscription, &task)
+	if err != nil {
+		return nil, err
+	}
+
+	var resource pricing.ListPricingResponse
+	refusal, err := internal.WaitForDryRun(ctx, a.taskWaiter.WaitForResource, *task.ID, &resource)
+	if err != nil {
+		return nil, err
+	}
+	return pricing.NewDryRunResult(refusal, resource), nil
+}
+
+// Estimate returns what the subscription would cost, by creating it as a dry run. An error is returned when the API
+// would refuse to create it.
+//
+// Estimate belongs to the subscriptions API rather than the pricing API: the dry runs of subscriptions, databases and
+// regions return pricing types, so the pricing package can't depend on subscriptions in turn.
+func (a *API) Estimate(ctx context.Context, subscription CreateSubscription) ([]*pricing.Pricing, error) {
+	result, err := a.DryRunCreate(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Valid {
+		return nil, fmt.Errorf("subscription %s would be refused: %w", redis.StringValue(subscription.Name), result.Refusal)
+	}
+	return result.Pricing, nil
+}
@@ -0,0 +1,108 @@
+package subscriptions
+
+import "slices"
+
+// Status is the value of the `Status` field in `Subscription`. Values introduced by the API after this version of
+// the library are unmarshalled as-is rather than rejected, and report false from IsValid.
+type Status string
+
+const (
+	// SubscriptionStatusActive is the active value of the `Status` field in `Subscription`
+	SubscriptionStatusActive Status = "active"
+	// SubscriptionStatusPending is the pending value of the `Status` field in `Subscription`
+	SubscriptionStatusPending Status = "pending"
+	// SubscriptionStatusEncryptionKeyPending is the encryption key pending value of the `Status` field in `Subscription`
+	SubscriptionStatusEncryptionKeyPending Status = "encryption_key_pending"
+	// SubscriptionStatusError is the error value of the `Status` field in `Subscription`
+	SubscriptionStatusError Status = "error"
+	// SubscriptionStatusDeleting is the deleting value of the `Status` field in `Subscription`
+	SubscriptionStatusDeleting Status = "deleting"
+)
+
+func StatusValues() []Status {
+	return []Status{
+		SubscriptionStatusActive,
+		SubscriptionStatusPending,
+		SubscriptionStatusEncryptionKeyPending,
+		SubscriptionStatusError,
+		SubscriptionStatusDeleting,
+	}
+}
+
+// IsValid reports whether the status is one known to this version of the library.
+func (s Status) IsValid() bool {
+	return slices.Contains(StatusValues(), s)
+}
+
+// IsTerminal reports whether the subscription has settled, i.e. it won't change status again without another
+// request. A subscription waiting for its encryption key is settled until the key is granted.
+func (s Status) IsTerminal() bool {
+	return s == SubscriptionStatusActive || s == SubscriptionStatusError || s == SubscriptionStatusEncryptionKeyPending
+}
+
+// IsError reports whether the subscription has failed.
+func (s Status) IsError() bool {
+	return s == SubscriptionStatusError
+}
+
+// VPCPeeringStatus is the value of the `Status` field in `VPCPeering` and `ActiveActiveVPCPeering`.
+type VPCPeeringStatus string
+
+const (
+	// VPCPeeringStatusInitiatingRequest is the initiating request value of the `Status` field in `VPCPeering`
+	VPCPeeringStatusInitiatingRequest VPCPeeringStatus = "initiating-request"
+	// VPCPeeringStatusActive is the active value of the `Status` field in `VPCPeering`
+	VPCPeeringStatusActive VPCPeeringStatus = "active"
+	// VPCPeeringStatusInactive is the inactive value of the `Status` field in `VPCPeering`
+	VPCPeeringStatusInactive VPCPeeringStatus = "inactive"
+	// VPCPeeringStatusPendingAcceptance is the pending acceptance value of the `Status` field in `VPCPeering`
+	VPCPeeringStatusPendingAcceptance VPCPeeringStatus = "pending-acceptance"
+	// VPCPeeringStatusFailed is the failed value of the `Status` field in `VPCPeering`
+	VPCPeeringStatusFailed VPCPeeringStatus = "failed"
+)
+
+func VPCPeeringStatusValues() []VPCPeeringStatus {
+	return []VPCPeeringStatus{
+		VPCPeeringStatusInitiatingRequest,
+		VPCPeeringStatusActive,
+		VPCPeeringStatusInactive,
+		VPCPeeringStatusPendingAcceptance,
+		VPCPeeringStatusFailed,
+	}
+}
+
+// IsValid reports whether the status is one known to this version of the library.
+func (s VPCPeeringStatus) IsValid() bool {
+	return slices.Contains(VPCPeeringStatusValues(), s)
+}
+
+// IsTerminal reports whether the peering has settled. A peering pending acceptance is waiting on the peer's cloud
+// account rather than on Redis Cloud, so it counts as settled.
+func (s VPCPeeringStatus) IsTerminal() bool {
+	return s == VPCPeeringStatusActive || s == VPCPeeringStatusInactive || s == VPCPeeringStatusPendingAcceptance || s == VPCPeeringStatusFailed
+}
+
+// IsError reports whether the peering has failed.
+func (s VPCPeeringStatus) IsError() bool {
+	return s == VPCPeeringStatusFailed
+}
+
+// DeploymentType is the value of the `DeploymentType` field of subscriptions.
+type DeploymentType string
+
+const (
+	SubscriptionDeploymentTypeSingleRegion DeploymentType = "single-region"
+	SubscriptionDeploymentTypeActiveActive DeploymentType = "active-active"
+)
+
+func DeploymentTypeValues() []string {
+	return []string{
+		string(SubscriptionDeploymentTypeSingleRegion),
+		string(SubscriptionDeploymentTypeActiveActive),
+	}
+}
+
+// IsValid reports whether the deployment type is one known to this version of the library.
+func (t DeploymentType) IsValid() bool {
+	return slices.Contains(DeploymentTypeValues(), string(t))
+}
@@ -0,0 +1,104 @@
+package subscriptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+)
+
+// ErrNoIdempotencyKey is returned by CreateIdempotent when the subscription has no name to identify it by.
+var ErrNoIdempotencyKey = errors.New("the subscription name is needed to create it idempotently")
+
+// CreateIdempotent creates a subscription like Create, unless it has already been created. The subscription name is its
+// idempotency key: when a previous call with the same name is still being processed by this client, whether
+// concurrently or e.g. it returned after its context timed out, the call waits for its task, and when a subscription of
+// the account already has the name, its identifier is returned. Either way, no duplicate is created, and retrying
+// CreateIdempotent after any error is safe. As the API doesn't require subscription names to be unique, an error is
+// returned when several subscriptions have the name. When the call options leave the task processing, a
+// *rediscloud_api.NotWaitedError is returned and the name stays reserved, so the next call waits for the same task.
+func (a *API) CreateIdempotent(ctx context.Context, subscription CreateSubscription) (int, error) {
+	if subscription.Name == nil {
+		return 0, ErrNoIdempotencyKey
+	}
+	key := *subscription.Name
+
+	for {
+		reservation, owner := a.inFlight.Reserve(key)
+		if owner {
+			return a.createReserved(ctx, reservation, subscription)
+		}
+
+		taskID, ok, err := reservation.Task(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			// The other call didn't create the subscription, so this one tries to
+			continue
+		}
+
+		internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish creating subscription %s", taskID, key), internal.TaskID(&taskID))
+
+		id, err := reservation.Wait(ctx, a.taskWaiter.WaitForResourceId)
+		if err == nil || !internal.IsTaskFailure(err) {
+			return id, err
+		}
+		// Nothing was created by the failed task, so the subscription is created again
+	}
+}
+
+// createReserved creates the subscription once its name has been reserved, unless the account already has it.
+func (a *API) createReserved(ctx context.Context, reservation *internal.Reservation, subscription CreateSubscription) (int, error) {
+	if id, ok, err := a.findByName(ctx, *subscription.Name); err != nil || ok {
+		reservation.Release()
+		return id, err
+	}
+
+	var task internal.TaskResponse
+	err := a.client.Post(ctx, "create subscription", "/subscriptions", subscription, &task)
+	if err != nil {
+		// The request may have been processed even though its response was lost. The name stays reserved until that's
+		// known, so that concurrent calls don't create the subscription again.
+		if id, ok, findErr := a.findByName(ctx, *subscription.Name); findErr == nil && ok {
+			reservation.Release()
+			return id, nil
+		}
+		reservation.Fail(err)
+		return 0, err
+	}
+	reservation.Start(*task.ID)
+
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish creating the subscription", task), internal.TaskID(task.ID))
+
+	id, err := reservation.Wait(ctx, a.taskWaiter.WaitForResourceId)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// findByName returns the identifier of the subscription with the given name, if any.
+func (a *API) findByName(ctx context.Context, name string) (int, bool, error) {
+	list, err := a.List(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	var found []int
+	for _, subscription := range list {
+		if redis.StringValue(subscription.Name) == name {
+			found = append(found, redis.IntValue(subscription.ID))
+		}
+	}
+	switch len(found) {
+	case 0:
+		return 0, false, nil
+	case 1:
+		return found[0], true, nil
+	default:
+		return 0, false, fmt.Errorf("subscriptions %v are all named %q, so it's unknown which was created", found, name)
+	}
+}
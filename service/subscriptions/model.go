@@ -4,11 +4,12 @@ import (
 	"fmt"
 
 	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
 )
 
 type CreateSubscription struct {
 	Name                            *string                `json:"name,omitempty"`
-	DeploymentType                  *string                `json:"deploymentType,omitempty"`
+	DeploymentType                  *DeploymentType        `json:"deploymentType,omitempty"`
 	DryRun                          *bool                  `json:"dryRun,omitempty"`
 	PaymentMethodID                 *int                   `json:"paymentMethodId,omitempty"`
 	PaymentMethod                   *string                `json:"paymentMethod,omitempty"`
@@ -65,20 +66,20 @@ func (o ResourceTag) String() string {
 }
 
 type CreateDatabase struct {
-	Name                       *string                  `json:"name,omitempty"`
-	Protocol                   *string                  `json:"protocol,omitempty"`
-	MemoryLimitInGB            *float64                 `json:"memoryLimitInGb,omitempty"`
-	DatasetSizeInGB            *float64                 `json:"datasetSizeInGb,omitempty"`
-	SupportOSSClusterAPI       *bool                    `json:"supportOSSClusterApi,omitempty"`
-	DataPersistence            *string                  `json:"dataPersistence,omitempty"`
-	Replication                *bool                    `json:"replication,omitempty"`
-	ThroughputMeasurement      *CreateThroughput        `json:"throughputMeasurement,omitempty"`
-	LocalThroughputMeasurement []*CreateLocalThroughput `json:"localThroughputMeasurement,omitempty"`
-	Modules                    []*CreateModules         `json:"modules,omitempty"`
-	Quantity                   *int                     `json:"quantity,omitempty"`
-	AverageItemSizeInBytes     *int                     `json:"averageItemSizeInBytes,omitempty"`
-	RamPercentage              *int                     `json:"ramPercentage,omitempty"`
-	QueryPerformanceFactor     *string                  `json:"queryPerformanceFactor,omitempty"`
+	Name                       *string                    `json:"name,omitempty"`
+	Protocol                   *string                    `json:"protocol,omitempty"`
+	MemoryLimitInGB            *float64                   `json:"memoryLimitInGb,omitempty"`
+	DatasetSizeInGB            *float64                   `json:"datasetSizeInGb,omitempty"`
+	SupportOSSClusterAPI       *bool                      `json:"supportOSSClusterApi,omitempty"`
+	DataPersistence            *databases.DataPersistence `json:"dataPersistence,omitempty"`
+	Replication                *bool                      `json:"replication,omitempty"`
+	ThroughputMeasurement      *CreateThroughput          `json:"throughputMeasurement,omitempty"`
+	LocalThroughputMeasurement []*CreateLocalThroughput   `json:"localThroughputMeasurement,omitempty"`
+	Modules                    []*CreateModules           `json:"modules,omitempty"`
+	Quantity                   *int                       `json:"quantity,omitempty"`
+	AverageItemSizeInBytes     *int                       `json:"averageItemSizeInBytes,omitempty"`
+	RamPercentage              *int                       `json:"ramPercentage,omitempty"`
+	QueryPerformanceFactor     *string                    `json:"queryPerformanceFactor,omitempty"`
 }
 
 func (o CreateDatabase) String() string {
@@ -147,8 +148,8 @@ func (o UpdateSubscriptionCMKs) String() string {
 type Subscription struct {
 	ID                              *int                             `json:"id,omitempty"`
 	Name                            *string                          `json:"name,omitempty"`
-	Status                          *string                          `json:"status,omitempty"`
-	DeploymentType                  *string                          `json:"deploymentType,omitempty"`
+	Status                          *Status                          `json:"status,omitempty"`
+	DeploymentType                  *DeploymentType                  `json:"deploymentType,omitempty"`
 	PaymentMethod                   *string                          `json:"paymentMethodType,omitempty"`
 	PaymentMethodID                 *int                             `json:"paymentMethodId,omitempty"`
 	MemoryStorage                   *string                          `json:"memoryStorage,omitempty"`
@@ -262,19 +263,19 @@ type listVpcPeering struct {
 }
 
 type VPCPeering struct {
-	ID               *int    `json:"vpcPeeringId,omitempty"`
-	Status           *string `json:"status,omitempty"`
-	AWSAccountID     *string `json:"awsAccountId,omitempty"`
-	AWSPeeringID     *string `json:"awsPeeringUid,omitempty"`
-	VPCId            *string `json:"vpcUid,omitempty"`
-	VPCCidr          *string `json:"vpcCidr,omitempty"`
-	VPCCidrs         []*CIDR `json:"vpcCidrs,omitempty"`
-	GCPProjectUID    *string `json:"projectUid,omitempty"`
-	NetworkName      *string `json:"networkName,omitempty"`
-	RedisProjectUID  *string `json:"redisProjectUid,omitempty"`
-	RedisNetworkName *string `json:"redisNetworkName,omitempty"`
-	CloudPeeringID   *string `json:"cloudPeeringId,omitempty"`
-	Region           *string `json:"regionName,omitempty"`
+	ID               *int              `json:"vpcPeeringId,omitempty"`
+	Status           *VPCPeeringStatus `json:"status,omitempty"`
+	AWSAccountID     *string           `json:"awsAccountId,omitempty"`
+	AWSPeeringID     *string           `json:"awsPeeringUid,omitempty"`
+	VPCId            *string           `json:"vpcUid,omitempty"`
+	VPCCidr          *string           `json:"vpcCidr,omitempty"`
+	VPCCidrs         []*CIDR           `json:"vpcCidrs,omitempty"`
+	GCPProjectUID    *string           `json:"projectUid,omitempty"`
+	NetworkName      *string           `json:"networkName,omitempty"`
+	RedisProjectUID  *string           `json:"redisProjectUid,omitempty"`
+	RedisNetworkName *string           `json:"redisNetworkName,omitempty"`
+	CloudPeeringID   *string           `json:"cloudPeeringId,omitempty"`
+	Region           *string           `json:"regionName,omitempty"`
 }
 
 func (o VPCPeering) String() string {
@@ -293,22 +294,22 @@ type ActiveActiveVpcRegion struct {
 }
 
 type ActiveActiveVPCPeering struct {
-	ID                *int    `json:"id,omitempty"`
-	Status            *string `json:"status,omitempty"`
-	RegionId          *int    `json:"regionId,omitempty"`
-	RegionName        *string `json:"regionName,omitempty"`
-	AWSAccountID      *string `json:"awsAccountId,omitempty"`
-	AWSPeeringID      *string `json:"awsPeeringUid,omitempty"`
-	VPCId             *string `json:"vpcUid,omitempty"`
-	VPCCidr           *string `json:"vpcCidr,omitempty"`
-	VPCCidrs          []*CIDR `json:"vpcCidrs,omitempty"`
-	GCPProjectUID     *string `json:"vpcProjectUid,omitempty"`
-	NetworkName       *string `json:"vpcNetworkName,omitempty"`
-	RedisProjectUID   *string `json:"redisProjectUid,omitempty"`
-	RedisNetworkName  *string `json:"redisNetworkName,omitempty"`
-	CloudPeeringID    *string `json:"cloudPeeringId,omitempty"`
-	SourceRegion      *string `json:"sourceRegion,omitempty"`
-	DestinationRegion *string `json:"destinationRegion,omitempty"`
+	ID                *int              `json:"id,omitempty"`
+	Status            *VPCPeeringStatus `json:"status,omitempty"`
+	RegionId          *int              `json:"regionId,omitempty"`
+	RegionName        *string           `json:"regionName,omitempty"`
+	AWSAccountID      *string           `json:"awsAccountId,omitempty"`
+	AWSPeeringID      *string           `json:"awsPeeringUid,omitempty"`
+	VPCId             *string           `json:"vpcUid,omitempty"`
+	VPCCidr           *string           `json:"vpcCidr,omitempty"`
+	VPCCidrs          []*CIDR           `json:"vpcCidrs,omitempty"`
+	GCPProjectUID     *string           `json:"vpcProjectUid,omitempty"`
+	NetworkName       *string           `json:"vpcNetworkName,omitempty"`
+	RedisProjectUID   *string           `json:"redisProjectUid,omitempty"`
+	RedisNetworkName  *string           `json:"redisNetworkName,omitempty"`
+	CloudPeeringID    *string           `json:"cloudPeeringId,omitempty"`
+	SourceRegion      *string           `json:"sourceRegion,omitempty"`
+	DestinationRegion *string           `json:"destinationRegion,omitempty"`
 }
 
 func (o ActiveActiveVPCPeering) String() string {
@@ -366,30 +367,3 @@ type NotFound struct {
 func (f *NotFound) Error() string {
 	return fmt.Sprintf("subscription %d not found", f.ID)
 }
-
-const (
-	// SubscriptionStatusActive is the active value of the `Status` field in `Subscription`
-	SubscriptionStatusActive = "active"
-	// SubscriptionStatusPending is the pending value of the `Status` field in `Subscription`
-	SubscriptionStatusPending = "pending"
-	// SubscriptionStatusEncryptionKeyPending is the encryption key pending value of the `Status` field in `Subscription`
-	SubscriptionStatusEncryptionKeyPending = "encryption_key_pending"
-	// SubscriptionStatusError is the error value of the `Status` field in `Subscription`
-	SubscriptionStatusError = "error"
-	// SubscriptionStatusDeleting is the deleting value of the `Status` field in `Subscription`
-	SubscriptionStatusDeleting = "deleting"
-
-	// VPCPeeringStatusInitiatingRequest is the initiating request value of the `Status` field in `VPCPeering`
-	VPCPeeringStatusInitiatingRequest = "initiating-request"
-	// VPCPeeringStatusActive is the active value of the `Status` field in `VPCPeering`
-	VPCPeeringStatusActive = "active"
-	// VPCPeeringStatusInactive is the inactive value of the `Status` field in `VPCPeering`
-	VPCPeeringStatusInactive = "inactive"
-	// VPCPeeringStatusPendingAcceptance is the pending acceptance value of the `Status` field in `VPCPeering`
-	VPCPeeringStatusPendingAcceptance = "pending-acceptance"
-	// VPCPeeringStatusFailed is the failed value of the `Status` field in `VPCPeering`
-	VPCPeeringStatusFailed = "failed"
-
-	SubscriptionDeploymentTypeSingleRegion = "single-region"
-	SubscriptionDeploymentTypeActiveActive = "active-active"
-)
@@ -30,6 +30,8 @@ type API struct {
 	client     HttpClient
 	taskWaiter TaskWaiter
 	logger     Log
+	cascade    CascadeServices
+	inFlight   internal.InFlight
 }
 
 func NewAPI(client HttpClient, taskWaiter TaskWaiter, logger Log) *API {
@@ -44,7 +46,7 @@ func (a *API) Create(ctx context.Context, subscription CreateSubscription) (int,
 		return 0, err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish creating the subscription", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish creating the subscription", task), internal.TaskID(task.ID))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -84,7 +86,7 @@ func (a *API) Update(ctx context.Context, id int, subscription UpdateSubscriptio
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for task %s to finish updating the subscription", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish updating the subscription", task), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
@@ -102,7 +104,7 @@ func (a *API) UpdateCMKs(ctx context.Context, id int, subscriptionCMKs UpdateSub
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for task %s to finish updating subscription %d", task, id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish updating subscription %d", task, id), internal.TaskID(task.ID), internal.SubscriptionID(id))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
@@ -123,7 +125,7 @@ func (a *API) UpdateResourceTags(ctx context.Context, id int, body UpdateResourc
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for task %s to finish updating resource tags for subscription %d", task, id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish updating resource tags for subscription %d", task, id), internal.TaskID(task.ID), internal.SubscriptionID(id))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
@@ -142,7 +144,7 @@ func (a *API) Delete(ctx context.Context, id int) error {
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for subscription %d to finish being deleted", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for subscription %d to finish being deleted", id), internal.SubscriptionID(id))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -156,7 +158,7 @@ func (a *API) GetCIDRAllowlist(ctx context.Context, id int) (*CIDRAllowlist, err
 		return nil, wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for subscription %d CIDR allowlist to be retrieved", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for subscription %d CIDR allowlist to be retrieved", id), internal.SubscriptionID(id))
 
 	var response CIDRAllowlist
 	err = a.taskWaiter.WaitForResource(ctx, *task.ID, &response)
@@ -176,7 +178,7 @@ func (a *API) UpdateCIDRAllowlist(ctx context.Context, id int, cidr UpdateCIDRAl
 		return wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for subscription %d CIDR allowlist to finish being updated", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for subscription %d CIDR allowlist to finish being updated", id), internal.SubscriptionID(id))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -189,7 +191,7 @@ func (a *API) ListVPCPeering(ctx context.Context, id int) ([]*VPCPeering, error)
 		return nil, wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for subscription %d peering details to be retrieved", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for subscription %d peering details to be retrieved", id), internal.SubscriptionID(id))
 
 	var peering listVpcPeering
 	err = a.taskWaiter.WaitForResource(ctx, *task.ID, &peering)
@@ -207,7 +209,7 @@ func (a *API) ListActiveActiveVPCPeering(ctx context.Context, id int) ([]*Active
 		return nil, wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for subscription %d peering details to be retrieved", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for subscription %d peering details to be retrieved", id), internal.SubscriptionID(id))
 
 	var peering listActiveActiveVpcPeering
 	err = a.taskWaiter.WaitForResource(ctx, *task.ID, &peering)
@@ -226,7 +228,7 @@ func (a *API) CreateVPCPeering(ctx context.Context, id int, create CreateVPCPeer
 		return 0, wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for subscription %d peering details to be retrieved", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for subscription %d peering details to be retrieved", id), internal.SubscriptionID(id))
 
 	id, err = a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -243,7 +245,7 @@ func (a *API) CreateActiveActiveVPCPeering(ctx context.Context, id int, create C
 		return 0, wrap404Error(id, err)
 	}
 
-	a.logger.Printf("Waiting for subscription %d peering details to be retrieved", id)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for subscription %d peering details to be retrieved", id), internal.SubscriptionID(id))
 
 	id, err = a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -261,7 +263,7 @@ func (a *API) DeleteVPCPeering(ctx context.Context, subscription int, peering in
 		return err
 	}
 
-	a.logger.Printf("Waiting for peering %d for subscription %d to be deleted", peering, subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for peering %d for subscription %d to be deleted", peering, subscription), internal.ResourceID("peering", peering), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
@@ -273,7 +275,7 @@ func (a *API) DeleteActiveActiveVPCPeering(ctx context.Context, subscription int
 		return err
 	}
 
-	a.logger.Printf("Waiting for peering %d for subscription %d to be deleted", peering, subscription)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for peering %d for subscription %d to be deleted", peering, subscription), internal.ResourceID("peering", peering), internal.SubscriptionID(subscription))
 
 	return a.taskWaiter.Wait(ctx, *task.ID)
 }
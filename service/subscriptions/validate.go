@@ -0,0 +1,127 @@
+package subscriptions
+
+import (
+	"fmt"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/cloud_accounts"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+)
+
+const (
+	// PaymentMethodCreditCard bills the subscription to a credit card, identified by the `PaymentMethodID`
+	PaymentMethodCreditCard = "credit-card"
+	// PaymentMethodMarketplace bills the subscription through a cloud marketplace account
+	PaymentMethodMarketplace = "marketplace"
+)
+
+func PaymentMethodValues() []string {
+	return []string{
+		PaymentMethodCreditCard,
+		PaymentMethodMarketplace,
+	}
+}
+
+// Validate checks the request for problems that the API would otherwise reject, without contacting the API.
+func (o CreateSubscription) Validate() error {
+	v := internal.NewValidation("create subscription request")
+	internal.OneOf(v, "deploymentType", o.DeploymentType, DeploymentTypeValues())
+	internal.OneOf(v, "paymentMethod", o.PaymentMethod, PaymentMethodValues())
+	if redis.StringValue(o.PaymentMethod) == PaymentMethodMarketplace && o.PaymentMethodID != nil {
+		v.Addf("paymentMethodId can't be set when paymentMethod is %q", PaymentMethodMarketplace)
+	}
+	internal.OneOf(v, "memoryStorage", o.MemoryStorage, databases.MemoryStorageValues())
+	activeActive := redis.Value(o.DeploymentType) == SubscriptionDeploymentTypeActiveActive
+
+	v.Required("cloudProviders", len(o.CloudProviders) > 0)
+	for i, provider := range o.CloudProviders {
+		if provider == nil {
+			continue
+		}
+		field := fmt.Sprintf("cloudProviders[%d]", i)
+		internal.OneOf(v, field+".provider", provider.Provider, cloud_accounts.ProviderValues())
+		v.Required(field+".regions", len(provider.Regions) > 0)
+		for j, region := range provider.Regions {
+			if region == nil {
+				continue
+			}
+			regionField := fmt.Sprintf("%s.regions[%d]", field, j)
+			v.Required(regionField+".region", region.Region != nil)
+			var cidr *string
+			if region.Networking != nil {
+				cidr = region.Networking.DeploymentCIDR
+			}
+			// Every region of an Active-Active subscription needs its own, non-overlapping, deployment CIDR
+			if activeActive {
+				v.Required(regionField+".networking.deploymentCIDR", cidr != nil)
+			}
+			v.CIDR(regionField+".networking.deploymentCIDR", cidr)
+		}
+	}
+
+	v.Required("databases", len(o.Databases) > 0)
+	for i, db := range o.Databases {
+		if db == nil {
+			continue
+		}
+		field := fmt.Sprintf("databases[%d]", i)
+		v.Required(field+".name", db.Name != nil)
+		internal.OneOf(v, field+".protocol", db.Protocol, databases.ProtocolValues())
+		internal.OneOf(v, field+".dataPersistence", db.DataPersistence, databases.DataPersistenceValues())
+		v.Exclusive(map[string]bool{field + ".memoryLimitInGb": db.MemoryLimitInGB != nil, field + ".datasetSizeInGb": db.DatasetSizeInGB != nil})
+		internal.Positive(v, field+".memoryLimitInGb", db.MemoryLimitInGB)
+		internal.Positive(v, field+".datasetSizeInGb", db.DatasetSizeInGB)
+		internal.Positive(v, field+".quantity", db.Quantity)
+		internal.InRange(v, field+".ramPercentage", db.RamPercentage, 0, 100)
+		if db.ThroughputMeasurement != nil {
+			internal.Positive(v, field+".throughputMeasurement.value", db.ThroughputMeasurement.Value)
+		}
+		for j, throughput := range db.LocalThroughputMeasurement {
+			if throughput != nil {
+				v.Required(fmt.Sprintf("%s.localThroughputMeasurement[%d].region", field, j), throughput.Region != nil)
+			}
+		}
+	}
+
+	return v.Err()
+}
+
+// Validate checks the request for problems that the API would otherwise reject, without contacting the API.
+func (o CreateVPCPeering) Validate() error {
+	v := internal.NewValidation("create VPC peering request")
+	validatePeering(v, o.Provider, o.AWSAccountID, o.VPCId, o.VPCCidr, o.VPCCidrs, o.VPCProjectUID, o.VPCNetworkName)
+	if redis.StringValue(o.Provider) != "GCP" {
+		v.Required("region", o.Region != nil)
+	}
+	return v.Err()
+}
+
+// Validate checks the request for problems that the API would otherwise reject, without contacting the API.
+func (o CreateActiveActiveVPCPeering) Validate() error {
+	v := internal.NewValidation("create Active-Active VPC peering request")
+	v.Required("sourceRegion", o.SourceRegion != nil)
+	if redis.StringValue(o.Provider) != "GCP" {
+		v.Required("destinationRegion", o.DestinationRegion != nil)
+	}
+	validatePeering(v, o.Provider, o.AWSAccountID, o.VPCId, o.VPCCidr, o.VPCCidrs, o.VPCProjectUID, o.VPCNetworkName)
+	return v.Err()
+}
+
+// validatePeering checks the fields shared by both kinds of peering request. The provider defaults to AWS.
+func validatePeering(v *internal.Validation, provider, awsAccountId, vpcId, vpcCidr *string, vpcCidrs []*string, projectUid, networkName *string) {
+	internal.OneOf(v, "provider", provider, cloud_accounts.ProviderValues())
+
+	if redis.StringValue(provider) == "GCP" {
+		v.Required("vpcProjectUid", projectUid != nil)
+		v.Required("vpcNetworkName", networkName != nil)
+		return
+	}
+
+	v.Required("awsAccountId", awsAccountId != nil)
+	v.Required("vpcId", vpcId != nil)
+	v.Required("vpcCidr or vpcCidrs", vpcCidr != nil || len(vpcCidrs) > 0)
+	v.Exclusive(map[string]bool{"vpcCidr": vpcCidr != nil, "vpcCidrs": len(vpcCidrs) > 0})
+	v.CIDR("vpcCidr", vpcCidr)
+	v.CIDRs("vpcCidrs", vpcCidrs)
+}
@@ -0,0 +1,65 @@
+package subscriptions
+
+import (
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSubscription_Validate(t *testing.T) {
+	valid := CreateSubscription{
+		Name:           redis.String("example"),
+		DeploymentType: redis.Ptr(SubscriptionDeploymentTypeActiveActive),
+		CloudProviders: []*CreateCloudProvider{
+			{
+				Provider: redis.String("AWS"),
+				Regions: []*CreateRegion{
+					{Region: redis.String("us-east-1"), Networking: &CreateNetworking{DeploymentCIDR: redis.String("10.0.0.0/24")}},
+				},
+			},
+		},
+		Databases: []*CreateDatabase{
+			{Name: redis.String("db"), MemoryLimitInGB: redis.Float64(1), Quantity: redis.Int(1)},
+		},
+	}
+	assert.NoError(t, valid.Validate())
+
+	invalid := CreateSubscription{
+		DeploymentType:  redis.Ptr(SubscriptionDeploymentTypeActiveActive),
+		PaymentMethod:   redis.String(PaymentMethodMarketplace),
+		PaymentMethodID: redis.Int(1),
+		CloudProviders: []*CreateCloudProvider{
+			{
+				Provider: redis.String("Azure"),
+				Regions:  []*CreateRegion{{Region: redis.String("us-east-1")}},
+			},
+		},
+	}
+	var validationErr *internal.ValidationError
+	require.ErrorAs(t, invalid.Validate(), &validationErr)
+	assert.Equal(t, []string{
+		`paymentMethodId can't be set when paymentMethod is "marketplace"`,
+		`cloudProviders[0].provider must be one of AWS, GCP, got "Azure"`,
+		"cloudProviders[0].regions[0].networking.deploymentCIDR is required",
+		"databases is required",
+	}, validationErr.Problems)
+}
+
+func TestCreateVPCPeering_Validate(t *testing.T) {
+	assert.NoError(t, CreateVPCPeering{
+		Provider:       redis.String("GCP"),
+		VPCProjectUID:  redis.String("project"),
+		VPCNetworkName: redis.String("network"),
+	}.Validate())
+
+	assert.EqualError(t, CreateVPCPeering{
+		Region:       redis.String("us-east-1"),
+		AWSAccountID: redis.String("123456789012"),
+		VPCId:        redis.String("vpc-123"),
+		VPCCidr:      redis.String("10.0.0.0/24"),
+		VPCCidrs:     redis.StringSlice("10.1.0.0"),
+	}.Validate(), `invalid create VPC peering request: only one of vpcCidr, vpcCidrs may be set; vpcCidrs[0] must be a CIDR block, got "10.1.0.0"`)
+}
@@ -0,0 +1,56 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+)
+
+// Task is an asynchronous request being processed by the API.
+type Task struct {
+	CommandType *string   `json:"commandType,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Status      *string   `json:"status,omitempty"`
+	ID          *string   `json:"taskId,omitempty"`
+	Response    *Response `json:"response,omitempty"`
+}
+
+func (o Task) String() string {
+	return internal.ToString(o)
+}
+
+type Response struct {
+	// ID is the identifier of the resource the task created or changed
+	ID *int `json:"resourceId,omitempty"`
+	// Resource is returned as-is, as its shape depends on the command
+	Resource *json.RawMessage `json:"resource,omitempty"`
+	Error    *Error           `json:"error,omitempty"`
+}
+
+func (o Response) String() string {
+	return internal.ToString(o)
+}
+
+type Error struct {
+	Type        *string `json:"type,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Status      *string `json:"status,omitempty"`
+}
+
+func (e *Error) String() string {
+	return internal.ToString(e)
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s - %s: %s", redis.StringValue(e.Status), redis.StringValue(e.Type), redis.StringValue(e.Description))
+}
+
+type NotFound struct {
+	id string
+}
+
+func (f *NotFound) Error() string {
+	return fmt.Sprintf("task %s not found", f.id)
+}
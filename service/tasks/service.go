@@ -0,0 +1,85 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+)
+
+type HttpClient interface {
+	Get(ctx context.Context, name, path string, responseBody interface{}) error
+}
+
+type TaskWaiter interface {
+	WaitForTask(ctx context.Context, id string) (*internal.Task, error)
+}
+
+type API struct {
+	client     HttpClient
+	taskWaiter TaskWaiter
+}
+
+func NewAPI(client HttpClient, taskWaiter TaskWaiter) *API {
+	return &API{client: client, taskWaiter: taskWaiter}
+}
+
+// Get will retrieve the current state of a task, whether it's still being processed or not.
+func (a *API) Get(ctx context.Context, id string) (*Task, error) {
+	var task Task
+	err := a.client.Get(ctx, "retrieve task "+id, "/tasks/"+url.PathEscape(id), &task)
+	if err != nil {
+		return nil, wrap404Error(id, err)
+	}
+
+	return &task, nil
+}
+
+// Wait will poll a task until it has been processed, and return it. The task is returned along with the error when it
+// fails to be processed.
+func (a *API) Wait(ctx context.Context, id string) (*Task, error) {
+	task, err := a.taskWaiter.WaitForTask(ctx, id)
+	if err != nil {
+		var iErr *internal.Error
+		if errors.As(err, &iErr) && task != nil {
+			return fromInternal(task), fmt.Errorf("task %s failed: %w", id, err)
+		}
+		return nil, wrap404Error(id, err)
+	}
+
+	return fromInternal(task), nil
+}
+
+func fromInternal(task *internal.Task) *Task {
+	ret := &Task{
+		CommandType: task.CommandType,
+		Description: task.Description,
+		Status:      task.Status,
+		ID:          task.ID,
+	}
+	if task.Response != nil {
+		ret.Response = &Response{
+			ID:       task.Response.ID,
+			Resource: task.Response.Resource,
+		}
+		if task.Response.Error != nil {
+			ret.Response.Error = &Error{
+				Type:        task.Response.Error.Type,
+				Description: task.Response.Error.Description,
+				Status:      task.Response.Error.Status,
+			}
+		}
+	}
+	return ret
+}
+
+func wrap404Error(id string, err error) error {
+	var httpErr *internal.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+		return &NotFound{id: id}
+	}
+	return err
+}
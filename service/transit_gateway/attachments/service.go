@@ -182,14 +182,14 @@ func (a *API) get(ctx context.Context, message string, address string) (*GetAtta
 		return nil, err
 	}
 
-	a.logger.Printf("Waiting for tgwGetRequest %d to complete", task.ID)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for tgwGetRequest %s to complete", *task.ID), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	a.logger.Printf("tgwGetRequest %d completed", task.ID)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("tgwGetRequest %s completed", *task.ID), internal.TaskID(task.ID))
 
 	var getAttachmentsTask *GetAttachmentsTask
 	err = a.client.Get(ctx,
@@ -213,7 +213,7 @@ func (a *API) create(ctx context.Context, message string, address string) (int,
 		return 0, err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish creating the TGw attachment", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish creating the TGw attachment", task), internal.TaskID(task.ID))
 
 	id, err := a.taskWaiter.WaitForResourceId(ctx, *task.ID)
 	if err != nil {
@@ -231,7 +231,7 @@ func (a *API) update(ctx context.Context, message string, address string, cidrs
 		return err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish updating the TGw attachment", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish updating the TGw attachment", task), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
@@ -248,7 +248,7 @@ func (a *API) delete(ctx context.Context, message string, address string) error
 		return err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish deleting the TGw attachment", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish deleting the TGw attachment", task), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
@@ -265,14 +265,14 @@ func (a *API) listInvitations(ctx context.Context, message string, address strin
 		return nil, err
 	}
 
-	a.logger.Printf("Waiting for tgwListInvitationsRequest %d to complete", task.ID)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for tgwListInvitationsRequest %s to complete", *task.ID), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	a.logger.Printf("tgwListInvitationsRequest %d completed", task.ID)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("tgwListInvitationsRequest %s completed", *task.ID), internal.TaskID(task.ID))
 
 	var invitationsResponse *InvitationsResponse
 	err = a.client.Get(ctx,
@@ -295,7 +295,7 @@ func (a *API) acceptInvitation(ctx context.Context, message string, address stri
 		return err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish accepting the TGw invitation", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish accepting the TGw invitation", task), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
@@ -312,7 +312,7 @@ func (a *API) rejectInvitation(ctx context.Context, message string, address stri
 		return err
 	}
 
-	a.logger.Printf("Waiting for task %s to finish rejecting the TGw invitation", task)
+	internal.LogInfo(ctx, a.logger, fmt.Sprintf("Waiting for task %s to finish rejecting the TGw invitation", task), internal.TaskID(task.ID))
 
 	err = a.taskWaiter.Wait(ctx, *task.ID)
 	if err != nil {
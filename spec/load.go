@@ -0,0 +1,507 @@
+package spec
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/internal/jsonschema"
+	"gopkg.in/yaml.v3"
+)
+
+// Error is a problem found at a position in a spec file.
+type Error struct {
+	File   string
+	Line   int
+	Column int
+	// Path is the location of the problem within the spec, e.g. `subscriptions[0].databases[1].database.name`
+	Path    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	position := fmt.Sprintf("%s:%d", e.File, e.Line)
+	// The YAML parser only reports the line of syntax errors
+	if e.Column > 0 {
+		position += fmt.Sprintf(":%d", e.Column)
+	}
+	if e.Path == "" {
+		return fmt.Sprintf("%s: %s", position, e.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", position, e.Path, e.Message)
+}
+
+// Errors is every problem found while loading a spec file, in the order they appear in the file.
+type Errors []*Error
+
+func (e Errors) Error() string {
+	lines := make([]string, 0, len(e))
+	for _, err := range e {
+		lines = append(lines, err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Load reads and decodes the spec file at path. See Parse.
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(path, data)
+}
+
+// Parse decodes a spec written in YAML or JSON, using filename to report where problems are.
+//
+// References to environment variables in values, written as `${NAME}`, are replaced by the value of the variable,
+// so that secrets such as passwords and cloud credentials don't need to be kept in the spec. `$${` is left as a
+// literal `${`.
+//
+// Every request in the spec is validated as it's decoded. When any problems are found they are all returned as
+// Errors, each with the line and column they were found at - the field a validation problem is about, when it's set.
+func Parse(filename string, data []byte) (*Spec, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, yamlError(filename, err)
+	}
+
+	d := &decoder{file: filename}
+	spec := &Spec{}
+	if len(document.Content) > 0 {
+		d.decode(document.Content[0], reflect.ValueOf(spec).Elem(), "")
+	}
+	if len(d.errs) > 0 {
+		return nil, d.errs
+	}
+	return spec, nil
+}
+
+// yamlError converts the syntax errors of the YAML parser, which look like `yaml: line 3: ...`, into an Error.
+func yamlError(filename string, err error) error {
+	message := strings.TrimPrefix(err.Error(), "yaml: ")
+	line := 0
+	if rest, ok := strings.CutPrefix(message, "line "); ok {
+		if number, message2, ok := strings.Cut(rest, ": "); ok {
+			if n, convErr := strconv.Atoi(number); convErr == nil {
+				line, message = n, message2
+			}
+		}
+	}
+	return Errors{{File: filename, Line: line, Message: message}}
+}
+
+type decoder struct {
+	file string
+	errs Errors
+}
+
+func (d *decoder) addf(node *yaml.Node, path, format string, args ...interface{}) {
+	d.errs = append(d.errs, &Error{
+		File:    d.file,
+		Line:    node.Line,
+		Column:  node.Column,
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+var (
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	validatableType = reflect.TypeOf((*internal.Validatable)(nil)).Elem()
+)
+
+// decode sets v from node, following the same rules as encoding/json. Problems are recorded rather than returned, so
+// that decoding carries on and every problem is reported at once.
+func (d *decoder) decode(node *yaml.Node, v reflect.Value, path string) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	if v.Kind() == reflect.Ptr {
+		if isNull(node) {
+			return
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		d.decode(node, v.Elem(), path)
+		return
+	}
+
+	if v.Kind() != reflect.Interface && v.Addr().Type().Implements(unmarshalerType) {
+		d.decodeUnmarshaler(node, v, path)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		d.decodeStruct(node, v, path)
+	case reflect.Slice:
+		d.decodeSlice(node, v, path)
+	case reflect.Map:
+		d.decodeMap(node, v, path)
+	case reflect.Interface:
+		if value, ok := d.plain(node, path); ok && value != nil {
+			v.Set(reflect.ValueOf(value))
+		}
+	default:
+		d.decodeScalar(node, v, path)
+	}
+}
+
+func (d *decoder) decodeStruct(node *yaml.Node, v reflect.Value, path string) {
+	if node.Kind != yaml.MappingNode {
+		d.addf(node, path, "expected an object, got %s", describe(node))
+		return
+	}
+
+	fields := map[string]reflect.Value{}
+	collectFields(v, fields)
+
+	errs := len(d.errs)
+	seen := map[string]bool{}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		fieldPath := join(path, key.Value)
+		if seen[key.Value] {
+			d.addf(key, fieldPath, "is set more than once")
+			continue
+		}
+		seen[key.Value] = true
+
+		field, ok := fields[key.Value]
+		if !ok {
+			d.addf(key, fieldPath, "unknown field")
+			continue
+		}
+		d.decode(value, field, fieldPath)
+	}
+
+	// Validating a request which couldn't be decoded would only repeat the same problems
+	if len(d.errs) == errs && v.Type().Implements(validatableType) {
+		if err := v.Interface().(internal.Validatable).Validate(); err != nil {
+			d.addValidation(node, path, fields, err)
+		}
+	}
+}
+
+// addValidation records every problem found by validating the request decoded from node, at the field it's about
+// when the problem starts with one.
+func (d *decoder) addValidation(node *yaml.Node, path string, fields map[string]reflect.Value, err error) {
+	var validationErr *internal.ValidationError
+	if !errors.As(err, &validationErr) {
+		d.addf(node, path, "%s", err)
+		return
+	}
+
+	errs := len(d.errs)
+	for _, problem := range validationErr.Problems {
+		field, message, _ := strings.Cut(problem, " ")
+		name, _, _ := strings.Cut(strings.SplitN(field, ".", 2)[0], "[")
+		if _, ok := fields[name]; !ok || message == "" {
+			d.addf(node, path, "%s", problem)
+			continue
+		}
+		at := node
+		if found := lookup(node, field); found != nil {
+			at = found
+		}
+		d.addf(at, join(path, field), "%s", message)
+	}
+
+	// The problems are found in the order of the request's fields, rather than the file's
+	added := d.errs[errs:]
+	sort.SliceStable(added, func(i, j int) bool {
+		if added[i].Line != added[j].Line {
+			return added[i].Line < added[j].Line
+		}
+		return added[i].Column < added[j].Column
+	})
+}
+
+// lookup returns the node a field path such as `regions[0].networking.deploymentCIDR` refers to within node: the key
+// of a field, or the item of a list. It returns nil when the field isn't set.
+func lookup(node *yaml.Node, field string) *yaml.Node {
+	var found *yaml.Node
+	for _, segment := range strings.Split(field, ".") {
+		name, indexes, _ := strings.Cut(segment, "[")
+
+		found = nil
+		if node.Kind == yaml.AliasNode {
+			node = node.Alias
+		}
+		for i := 0; node.Kind == yaml.MappingNode && i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == name {
+				found, node = node.Content[i], node.Content[i+1]
+				break
+			}
+		}
+		if found == nil {
+			return nil
+		}
+
+		for indexes != "" {
+			var index string
+			index, indexes, _ = strings.Cut(indexes, "]")
+			indexes = strings.TrimPrefix(indexes, "[")
+			i, err := strconv.Atoi(index)
+			if node.Kind == yaml.AliasNode {
+				node = node.Alias
+			}
+			if err != nil || node.Kind != yaml.SequenceNode || i < 0 || i >= len(node.Content) {
+				return nil
+			}
+			found, node = node.Content[i], node.Content[i]
+		}
+	}
+	return found
+}
+
+// collectFields indexes the fields of a struct by their JSON name, including those promoted from embedded structs.
+func collectFields(v reflect.Value, fields map[string]reflect.Value) {
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		name, ok := jsonschema.FieldName(field)
+		if !ok {
+			continue
+		}
+		if name != "" {
+			fields[name] = v.Field(i)
+			continue
+		}
+		embedded := v.Field(i)
+		if embedded.Kind() == reflect.Ptr {
+			if embedded.IsNil() {
+				embedded.Set(reflect.New(embedded.Type().Elem()))
+			}
+			embedded = embedded.Elem()
+		}
+		collectFields(embedded, fields)
+	}
+}
+
+func (d *decoder) decodeSlice(node *yaml.Node, v reflect.Value, path string) {
+	if isNull(node) {
+		return
+	}
+	if node.Kind != yaml.SequenceNode {
+		d.addf(node, path, "expected a list, got %s", describe(node))
+		return
+	}
+	slice := reflect.MakeSlice(v.Type(), len(node.Content), len(node.Content))
+	for i, item := range node.Content {
+		d.decode(item, slice.Index(i), fmt.Sprintf("%s[%d]", path, i))
+	}
+	v.Set(slice)
+}
+
+func (d *decoder) decodeMap(node *yaml.Node, v reflect.Value, path string) {
+	if isNull(node) {
+		return
+	}
+	if node.Kind != yaml.MappingNode {
+		d.addf(node, path, "expected an object, got %s", describe(node))
+		return
+	}
+	if v.Type().Key().Kind() != reflect.String {
+		d.addf(node, path, "can't decode into %s", v.Type())
+		return
+	}
+	m := reflect.MakeMapWithSize(v.Type(), len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		elem := reflect.New(v.Type().Elem()).Elem()
+		d.decode(value, elem, join(path, key.Value))
+		m.SetMapIndex(reflect.ValueOf(key.Value).Convert(v.Type().Key()), elem)
+	}
+	v.Set(m)
+}
+
+func (d *decoder) decodeScalar(node *yaml.Node, v reflect.Value, path string) {
+	if node.Kind != yaml.ScalarNode || isNull(node) {
+		d.addf(node, path, "expected %s, got %s", kindName(v.Kind()), describe(node))
+		return
+	}
+	value, ok := d.expand(node, path)
+	if !ok {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			d.addf(node, path, "expected a boolean, got %q", value)
+			return
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			d.addf(node, path, "expected an integer, got %q", value)
+			return
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			d.addf(node, path, "expected a positive integer, got %q", value)
+			return
+		}
+		v.SetUint(i)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, v.Type().Bits())
+		if err != nil {
+			d.addf(node, path, "expected a number, got %q", value)
+			return
+		}
+		v.SetFloat(f)
+	default:
+		d.addf(node, path, "can't decode into %s", v.Type())
+	}
+}
+
+// decodeUnmarshaler hands the node, as JSON, to types which decode themselves, such as redis.Optional and time.Time.
+func (d *decoder) decodeUnmarshaler(node *yaml.Node, v reflect.Value, path string) {
+	value, ok := d.plain(node, path)
+	if !ok {
+		return
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		d.addf(node, path, "%s", err)
+		return
+	}
+	if err := v.Addr().Interface().(json.Unmarshaler).UnmarshalJSON(data); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			d.addf(node, path, "expected %s, got %s", kindName(typeErr.Type.Kind()), describe(node))
+			return
+		}
+		d.addf(node, path, "%s", err)
+	}
+}
+
+// plain converts a node into the values encoding/json works with, expanding environment variables in strings.
+func (d *decoder) plain(node *yaml.Node, path string) (interface{}, bool) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	ok := true
+	switch node.Kind {
+	case yaml.MappingNode:
+		m := map[string]interface{}{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			value, valueOk := d.plain(node.Content[i+1], join(path, key))
+			m[key], ok = value, ok && valueOk
+		}
+		return m, ok
+	case yaml.SequenceNode:
+		list := make([]interface{}, 0, len(node.Content))
+		for i, item := range node.Content {
+			value, itemOk := d.plain(item, fmt.Sprintf("%s[%d]", path, i))
+			list, ok = append(list, value), ok && itemOk
+		}
+		return list, ok
+	default:
+		var value interface{}
+		if err := node.Decode(&value); err != nil {
+			d.addf(node, path, "%s", err)
+			return nil, false
+		}
+		if _, isString := value.(string); isString {
+			return d.expand(node, path)
+		}
+		return value, true
+	}
+}
+
+// expand replaces references to environment variables in the value of a scalar node.
+func (d *decoder) expand(node *yaml.Node, path string) (string, bool) {
+	value := node.Value
+	if !strings.Contains(value, "${") {
+		return value, true
+	}
+
+	var b strings.Builder
+	ok := true
+	for {
+		i := strings.Index(value, "${")
+		if i < 0 {
+			b.WriteString(value)
+			break
+		}
+		if i > 0 && value[i-1] == '$' {
+			b.WriteString(value[:i-1] + "${")
+			value = value[i+2:]
+			continue
+		}
+		end := strings.Index(value[i:], "}")
+		if end < 0 {
+			d.addf(node, path, "unterminated reference to an environment variable in %q", node.Value)
+			return "", false
+		}
+		name := value[i+2 : i+end]
+		env, found := os.LookupEnv(name)
+		if !found {
+			d.addf(node, path, "environment variable %s is not set", name)
+			ok = false
+		}
+		b.WriteString(value[:i] + env)
+		value = value[i+end+1:]
+	}
+	return b.String(), ok
+}
+
+func isNull(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.Tag == "!!null"
+}
+
+func describe(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "an object"
+	case yaml.SequenceNode:
+		return "a list"
+	default:
+		if isNull(node) {
+			return "null"
+		}
+		return strconv.Quote(node.Value)
+	}
+}
+
+func kindName(kind reflect.Kind) string {
+	switch kind {
+	case reflect.String:
+		return "a string"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "an integer"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "a positive integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Slice, reflect.Array:
+		return "a list"
+	default:
+		return "an object"
+	}
+}
+
+func join(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
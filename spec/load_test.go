@@ -0,0 +1,92 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/tags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	t.Setenv("CLOUD_ACCOUNT_ID", "3")
+	t.Setenv("CACHE_PASSWORD", "s3cret")
+
+	actual, err := Load("testdata/valid.yaml")
+	require.NoError(t, err)
+
+	require.Len(t, actual.Subscriptions, 1)
+	subscription := actual.Subscriptions[0]
+	assert.Equal(t, redis.String("production"), subscription.Subscription.Name)
+	assert.Equal(t, redis.Int(3), subscription.Subscription.CloudProviders[0].CloudAccountID)
+	assert.Equal(t, redis.String("10.0.0.0/24"), subscription.Subscription.CloudProviders[0].Regions[0].Networking.DeploymentCIDR)
+	assert.Equal(t, redis.String("manual"), subscription.Maintenance.Mode)
+	assert.Equal(t, redis.StringSlice("Monday"), subscription.Maintenance.Windows[0].Days)
+
+	require.Len(t, subscription.Databases, 1)
+	database := subscription.Databases[0]
+	assert.Equal(t, redis.Float64(1), database.Database.MemoryLimitInGB)
+	assert.Equal(t, redis.Ptr(databases.DataPersistenceNone), database.Database.DataPersistence)
+	assert.Equal(t, redis.String("s3cret"), database.Database.Password)
+	assert.Equal(t, []*databases.Alert{{Name: redis.String("dataset-size"), Value: redis.Int(80)}}, database.Database.Alerts)
+	assert.Equal(t, []*tags.Tag{{Key: redis.String("team"), Value: redis.String("platform")}}, database.Tags)
+
+	assert.Equal(t, redis.String("+@read ~*"), actual.ACL.RedisRules[0].RedisRule)
+	assert.Equal(t, redis.Int(2), actual.ACL.Roles[0].RedisRules[0].Databases[0].DatabaseId)
+	assert.Equal(t, redis.String("${not-a-variable}"), actual.ACL.Users[0].Password)
+}
+
+func TestParse_JSON(t *testing.T) {
+	actual, err := Parse("spec.json", []byte(`{
+  "acl": {
+    "users": [{"name": "reader", "role": "readers", "password": "s3cret"}]
+  }
+}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, redis.String("reader"), actual.ACL.Users[0].Name)
+}
+
+func TestLoad_reportsEveryProblemWithItsPosition(t *testing.T) {
+	_, err := Load("testdata/invalid.yaml")
+
+	var errs Errors
+	require.ErrorAs(t, err, &errs)
+	assert.Equal(t, `testdata/invalid.yaml:6:27: subscriptions[0].subscription.cloudProviders[0].cloudAccountId: expected an integer, got "lots"
+testdata/invalid.yaml:17:21: subscriptions[0].databases[0].database.password: environment variable SPEC_TEST_UNSET is not set
+testdata/invalid.yaml:19:11: subscriptions[0].databases[1].database: only one of datasetSizeInGb, memoryLimitInGb may be set
+testdata/invalid.yaml:20:11: subscriptions[0].databases[1].database.memoryLimitInGb: must be greater than 0, got -1
+testdata/invalid.yaml:22:11: subscriptions[0].databases[1].database.dataEvictionPolicy: must be one of allkeys-lru, allkeys-lfu, allkeys-random, volatile-lru, volatile-lfu, volatile-random, volatile-ttl, noeviction, got "lru"
+testdata/invalid.yaml:23:9: subscriptions[0].databases[1].labels: unknown field`, err.Error())
+}
+
+func TestParse_reportsValidationProblemsAtTheirFields(t *testing.T) {
+	_, err := Parse("spec.yaml", []byte(`subscriptions:
+  - subscription:
+      cloudProviders:
+        - provider: AWS
+          regions:
+            - region: us-east-1
+              networking:
+                deploymentCIDR: not-a-cidr
+      databases:
+        - name: cache
+          memoryLimitInGb: 1
+    databases:
+      - database:
+          memoryLimitInGb: 1
+`))
+
+	var errs Errors
+	require.ErrorAs(t, err, &errs)
+	assert.Equal(t, `spec.yaml:8:17: subscriptions[0].subscription.cloudProviders[0].regions[0].networking.deploymentCIDR: must be a CIDR block, got "not-a-cidr"
+spec.yaml:14:11: subscriptions[0].databases[0].database.name: is required`, err.Error())
+}
+
+func TestParse_syntaxError(t *testing.T) {
+	_, err := Parse("spec.yaml", []byte("acl:\n  users: [\n"))
+
+	assert.EqualError(t, err, "spec.yaml:2: did not find expected node content")
+}
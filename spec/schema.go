@@ -0,0 +1,16 @@
+package spec
+
+//go:generate go test . -run TestSchema_isUpToDate -update
+
+import (
+	"github.com/RedisLabs/rediscloud-go-api/schema"
+)
+
+// SchemaID identifies the schema of spec files. Editors such as VS Code pick it up from the comment
+// `# yaml-language-server: $schema=<SchemaID>` at the top of a spec.
+const SchemaID = "https://raw.githubusercontent.com/RedisLabs/rediscloud-go-api/main/spec/spec.schema.json"
+
+// Schema returns the JSON Schema of spec files, as published in spec.schema.json.
+func Schema() ([]byte, error) {
+	return schema.Marshal(schema.NewGenerator(true).Generate(Spec{}, SchemaID, "Redis Cloud resource spec"))
+}
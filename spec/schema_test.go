@@ -0,0 +1,25 @@
+package spec
+
+import (
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite spec.schema.json")
+
+func TestSchema_isUpToDate(t *testing.T) {
+	actual, err := Schema()
+	require.NoError(t, err)
+
+	if *update {
+		require.NoError(t, os.WriteFile("spec.schema.json", actual, 0o644))
+	}
+
+	published, err := os.ReadFile("spec.schema.json")
+	require.NoError(t, err)
+	assert.Equal(t, string(published), string(actual), "spec.schema.json is out of date, run `go generate ./spec`")
+}
@@ -0,0 +1,95 @@
+// Package spec describes Redis Cloud resources in YAML or JSON files, so they can be kept alongside the rest of the
+// infrastructure definitions in version control.
+//
+// A spec is decoded straight into the SDK's request types, using the same field names as the API:
+//
+//	subscriptions:
+//	  - subscription:
+//	      name: production
+//	      cloudProviders:
+//	        - provider: AWS
+//	          cloudAccountId: 1
+//	          regions:
+//	            - region: us-east-1
+//	              networking:
+//	                deploymentCIDR: 10.0.0.0/24
+//	      databases:
+//	        - name: cache
+//	          memoryLimitInGb: 1
+//	    databases:
+//	      - database:
+//	          name: cache
+//	          memoryLimitInGb: 1
+//	          password: ${CACHE_PASSWORD}
+//	        tags:
+//	          - key: team
+//	            value: platform
+//
+// The schema of the format is published as spec.schema.json, and can be used by editors to complete and check specs.
+package spec
+
+import (
+	"github.com/RedisLabs/rediscloud-go-api/internal"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/redis_rules"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/roles"
+	"github.com/RedisLabs/rediscloud-go-api/service/access_control_lists/users"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/maintenance"
+	"github.com/RedisLabs/rediscloud-go-api/service/regions"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/RedisLabs/rediscloud-go-api/service/tags"
+)
+
+// Spec is the root of a spec file.
+type Spec struct {
+	Subscriptions []*Subscription `json:"subscriptions,omitempty"`
+	ACL           *ACL            `json:"acl,omitempty"`
+}
+
+func (o Spec) String() string {
+	return internal.ToString(o)
+}
+
+// Subscription is a Pro subscription along with everything created inside it.
+type Subscription struct {
+	Subscription subscriptions.CreateSubscription `json:"subscription"`
+	Maintenance  *maintenance.Maintenance         `json:"maintenance,omitempty"`
+	// Regions are added to an Active-Active subscription after it has been created
+	Regions               []*regions.CreateRegion `json:"regions,omitempty"`
+	Databases             []*Database             `json:"databases,omitempty"`
+	ActiveActiveDatabases []*ActiveActiveDatabase `json:"activeActiveDatabases,omitempty"`
+}
+
+func (o Subscription) String() string {
+	return internal.ToString(o)
+}
+
+type Database struct {
+	Database databases.CreateDatabase `json:"database"`
+	Tags     []*tags.Tag              `json:"tags,omitempty"`
+}
+
+func (o Database) String() string {
+	return internal.ToString(o)
+}
+
+type ActiveActiveDatabase struct {
+	Database databases.CreateActiveActiveDatabase `json:"database"`
+	Tags     []*tags.Tag                          `json:"tags,omitempty"`
+}
+
+func (o ActiveActiveDatabase) String() string {
+	return internal.ToString(o)
+}
+
+// ACL is the account-wide access control configuration. Rules are created first, as roles refer to them by name,
+// then roles, which users refer to.
+type ACL struct {
+	RedisRules []*redis_rules.CreateRedisRuleRequest `json:"redisRules,omitempty"`
+	Roles      []*roles.CreateRoleRequest            `json:"roles,omitempty"`
+	Users      []*users.CreateUserRequest            `json:"users,omitempty"`
+}
+
+func (o ACL) String() string {
+	return internal.ToString(o)
+}
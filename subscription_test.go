@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
 	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -128,7 +129,7 @@ func TestSubscription_Create(t *testing.T) {
 				Protocol:             redis.String("redis"),
 				DatasetSizeInGB:      redis.Float64(1),
 				SupportOSSClusterAPI: redis.Bool(true),
-				DataPersistence:      redis.String("none"),
+				DataPersistence:      redis.Ptr(databases.DataPersistenceNone),
 				Replication:          redis.Bool(false),
 				ThroughputMeasurement: &subscriptions.CreateThroughput{
 					By:    redis.String("operations-per-second"),
@@ -296,7 +297,7 @@ func TestSubscription_Create_PublicEndpointAccess(t *testing.T) {
 				Protocol:             redis.String("redis"),
 				DatasetSizeInGB:      redis.Float64(1),
 				SupportOSSClusterAPI: redis.Bool(true),
-				DataPersistence:      redis.String("none"),
+				DataPersistence:      redis.Ptr(databases.DataPersistenceNone),
 				Replication:          redis.Bool(false),
 				ThroughputMeasurement: &subscriptions.CreateThroughput{
 					By:    redis.String("operations-per-second"),
@@ -427,7 +428,7 @@ func TestFlexSubscription_Create(t *testing.T) {
 				Protocol:             redis.String("redis"),
 				DatasetSizeInGB:      redis.Float64(1),
 				SupportOSSClusterAPI: redis.Bool(true),
-				DataPersistence:      redis.String("none"),
+				DataPersistence:      redis.Ptr(databases.DataPersistenceNone),
 				Replication:          redis.Bool(false),
 				ThroughputMeasurement: &subscriptions.CreateThroughput{
 					By:    redis.String("operations-per-second"),
@@ -562,7 +563,7 @@ func TestSubscription_CreateCMK(t *testing.T) {
 				Protocol:             redis.String("redis"),
 				DatasetSizeInGB:      redis.Float64(1),
 				SupportOSSClusterAPI: redis.Bool(true),
-				DataPersistence:      redis.String("none"),
+				DataPersistence:      redis.Ptr(databases.DataPersistenceNone),
 				Replication:          redis.Bool(false),
 				ThroughputMeasurement: &subscriptions.CreateThroughput{
 					By:    redis.String("operations-per-second"),
@@ -695,7 +696,7 @@ func TestSubscription_List(t *testing.T) {
 		{
 			ID:                redis.Int(1),
 			Name:              redis.String("sdk"),
-			Status:            redis.String("active"),
+			Status:            redis.Ptr(subscriptions.SubscriptionStatusActive),
 			PaymentMethodID:   redis.Int(2),
 			PaymentMethod:     redis.String("credit-card"),
 			MemoryStorage:     redis.String("ram"),
@@ -726,7 +727,7 @@ func TestSubscription_List(t *testing.T) {
 		{
 			ID:                redis.Int(2),
 			Name:              redis.String("TF Example Subscription demo"),
-			Status:            redis.String("pending"),
+			Status:            redis.Ptr(subscriptions.SubscriptionStatusPending),
 			PaymentMethodID:   redis.Int(3),
 			PaymentMethod:     redis.String("credit-card"),
 			MemoryStorage:     redis.String("ram"),
@@ -812,7 +813,7 @@ func TestSubscription_Get(t *testing.T) {
 	assert.Equal(t, &subscriptions.Subscription{
 		ID:                redis.Int(1),
 		Name:              redis.String("Get-test"),
-		Status:            redis.String("active"),
+		Status:            redis.Ptr(subscriptions.SubscriptionStatusActive),
 		PaymentMethod:     redis.String("credit-card"),
 		PaymentMethodID:   redis.Int(2),
 		MemoryStorage:     redis.String("ram"),
@@ -898,7 +899,7 @@ func TestSubscription_Get_OptionalFields(t *testing.T) {
 	assert.Equal(t, &subscriptions.Subscription{
 		ID:                              redis.Int(2),
 		Name:                            redis.String("Get-test-public-endpoint"),
-		Status:                          redis.String("active"),
+		Status:                          redis.Ptr(subscriptions.SubscriptionStatusActive),
 		PaymentMethod:                   redis.String("credit-card"),
 		PaymentMethodID:                 redis.Int(2),
 		MemoryStorage:                   redis.String("ram"),
@@ -1426,7 +1427,7 @@ func TestSubscription_ListVPCPeering(t *testing.T) {
 			VPCId:        redis.String("vpc-deadbeef"),
 			VPCCidr:      redis.String("10.0.0.0/24"),
 			AWSPeeringID: redis.String("pcx-0123456789"),
-			Status:       redis.String("done"),
+			Status:       redis.Ptr(subscriptions.VPCPeeringStatus("done")),
 			Region:       redis.String("eu-west-2"),
 		},
 	}, actual)
@@ -1485,7 +1486,7 @@ func TestSubscription_ListVPCPeering_gcp(t *testing.T) {
 	assert.ElementsMatch(t, []*subscriptions.VPCPeering{
 		{
 			ID:               redis.Int(11),
-			Status:           redis.String("inactive"),
+			Status:           redis.Ptr(subscriptions.VPCPeeringStatusInactive),
 			GCPProjectUID:    redis.String("cloud-api-123456"),
 			NetworkName:      redis.String("cloud-api-vpc-peering-test"),
 			RedisProjectUID:  redis.String("v00d1c1f22233333f-tp"),
@@ -1720,3 +1721,159 @@ func listRegionsExpected() []*subscriptions.ActiveActiveRegion {
 
 	return []*subscriptions.ActiveActiveRegion{region1Struct, region2Struct}
 }
+
+func TestCreateBuilder_reportsValidationError(t *testing.T) {
+	_, err := subscriptions.NewCreate("example").AWS(1).Region("us-east-1", "not-a-cidr").Build()
+
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []string{
+		`cloudProviders[0].regions[0].networking.deploymentCIDR must be a CIDR block, got "not-a-cidr"`,
+		"databases is required",
+	}, validationErr.Problems)
+}
+
+func TestSubscription_DeleteCascade(t *testing.T) {
+	requests := []endpointRequest{
+		getRequest(t, "/subscriptions/1234", `{
+  "id": 1234,
+  "name": "Example",
+  "status": "active",
+  "deploymentType": "single-region",
+  "cloudDetails": [
+    {
+      "provider": "AWS",
+      "cloudAccountId": 5678
+    }
+  ]
+}`),
+	}
+	requests = append(requests, getTaskFlow(t, "/subscriptions/1234/private-link", "private-link", "privateLinkGetRequest", `{"links": []}`)...)
+	tgws := getTaskFlow(t, "/subscriptions/1234/transitGateways", "tgw", "tgwGetRequest", `{
+  "tgws": [
+    {"id": 36, "awsTgwUid": "tgw-attached", "attachmentUid": "tgw-attach-123", "status": "available"},
+    {"id": 37, "awsTgwUid": "tgw-shared", "status": "available"}
+  ]
+}`)
+	requests = append(requests, append(tgws, tgws[1])...)
+	requests = append(requests, getTaskFlow(t, "/subscriptions/1234/peerings", "peerings", "peeringListRequest", `{
+  "peerings": [
+    {"vpcPeeringId": 10, "status": "done"}
+  ]
+}`)...)
+	requests = append(requests,
+		getRequestWithQuery(t, "/subscriptions/1234/databases", map[string][]string{"limit": {"100"}, "offset": {"0"}}, `{
+  "accountId": 2,
+  "subscription": [
+    {
+      "subscriptionId": 1234,
+      "databases": [
+        {"databaseId": 42, "name": "example-db"}
+      ]
+    }
+  ]
+}`),
+		getRequestWithQueryAndStatus(t, "/subscriptions/1234/databases", map[string][]string{"limit": {"100"}, "offset": {"100"}}, 404, ""),
+	)
+	requests = append(requests, taskFlow(t, "DELETE", "/subscriptions/1234/transitGateways/36/attachment", "", "delete-tgw", "tgwDeleteRequest")...)
+	requests = append(requests, taskFlow(t, "DELETE", "/subscriptions/1234/peerings/10", "", "delete-peering", "vpcPeeringDeleteRequest")...)
+	requests = append(requests, taskFlow(t, "DELETE", "/subscriptions/1234/databases/42", "", "delete-db", "deleteDatabaseRequest")...)
+	requests = append(requests, taskFlow(t, "DELETE", "/subscriptions/1234", "", "delete-sub", "subscriptionDeleteRequest")...)
+
+	s := httptest.NewServer(testServer("apiKey", "secret", requests...))
+
+	subject, err := clientFromTestServer(s, "apiKey", "secret")
+	require.NoError(t, err)
+
+	plan, err := subject.Subscription.DeleteCascade(context.TODO(), 1234, subscriptions.DeleteCascadeOptions{Confirm: "Example"})
+	require.NoError(t, err)
+
+	assert.Equal(t, &subscriptions.DeletionPlan{
+		SubscriptionID: 1234,
+		Steps: []string{
+			"delete Transit Gateway attachment to 36",
+			"delete VPC peering 10",
+			"delete database 42 (example-db)",
+			"delete subscription 1234",
+		},
+	}, plan)
+}
+
+func TestSubscription_DeleteCascade_activeActiveWithoutRegions(t *testing.T) {
+	requests := []endpointRequest{
+		getRequest(t, "/subscriptions/1234", `{
+  "id": 1234,
+  "name": "Example",
+  "deploymentType": "active-active",
+  "cloudDetails": [{"provider": "GCP"}]
+}`),
+		getRequest(t, "/subscriptions/1234/regions", `{"subscriptionId": 1234, "regions": []}`),
+	}
+	// Without any region there's nothing to look up per region, and the single region APIs aren't used instead
+	requests = append(requests, getTaskFlow(t, "/subscriptions/1234/regions/peerings/", "peerings", "peeringListRequest", `{"regions": []}`)...)
+	requests = append(requests, getRequestWithQueryAndStatus(t, "/subscriptions/1234/databases", map[string][]string{"limit": {"100"}, "offset": {"0"}}, 404, ""))
+
+	s := httptest.NewServer(testServer("apiKey", "secret", requests...))
+
+	subject, err := clientFromTestServer(s, "apiKey", "secret")
+	require.NoError(t, err)
+
+	plan, err := subject.Subscription.DeleteCascade(context.TODO(), 1234, subscriptions.DeleteCascadeOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"delete subscription 1234"}, plan.Steps)
+}
+
+func TestSubscription_DeleteCascade_requiresConfirmation(t *testing.T) {
+	subscription := getRequest(t, "/subscriptions/1234", `{
+  "id": 1234,
+  "name": "Example",
+  "deploymentType": "single-region",
+  "cloudDetails": [{"provider": "GCP"}]
+}`)
+	lookups := []endpointRequest{subscription}
+	lookups = append(lookups, getRequestWithStatus(t, "/subscriptions/1234/private-service-connect", 404, ""))
+	lookups = append(lookups, getTaskFlow(t, "/subscriptions/1234/peerings", "peerings", "peeringListRequest", `{"peerings": []}`)...)
+	lookups = append(lookups, getRequestWithQueryAndStatus(t, "/subscriptions/1234/databases", map[string][]string{"limit": {"100"}, "offset": {"0"}}, 404, ""))
+
+	// The lookups happen twice: once for the dry run and once for the unconfirmed deletion, and nothing is deleted
+	s := httptest.NewServer(testServer("apiKey", "secret", append(lookups, lookups...)...))
+
+	subject, err := clientFromTestServer(s, "apiKey", "secret")
+	require.NoError(t, err)
+
+	plan, err := subject.Subscription.DeleteCascade(context.TODO(), 1234, subscriptions.DeleteCascadeOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"delete subscription 1234"}, plan.Steps)
+	assert.Equal(t, "Deleting subscription 1234 will:\n1. delete subscription 1234", plan.String())
+
+	_, err = subject.Subscription.DeleteCascade(context.TODO(), 1234, subscriptions.DeleteCascadeOptions{Confirm: "example"})
+	assert.ErrorIs(t, err, subscriptions.ErrNotConfirmed)
+}
+
+func TestSubscription_DeleteCascade_confirmsUnnamedSubscriptionWithID(t *testing.T) {
+	subscription := getRequest(t, "/subscriptions/1234", `{
+  "id": 1234,
+  "deploymentType": "single-region",
+  "cloudDetails": [{"provider": "GCP"}]
+}`)
+	lookups := []endpointRequest{subscription}
+	lookups = append(lookups, getRequestWithStatus(t, "/subscriptions/1234/private-service-connect", 404, ""))
+	lookups = append(lookups, getTaskFlow(t, "/subscriptions/1234/peerings", "peerings", "peeringListRequest", `{"peerings": []}`)...)
+	lookups = append(lookups, getRequestWithQueryAndStatus(t, "/subscriptions/1234/databases", map[string][]string{"limit": {"100"}, "offset": {"0"}}, 404, ""))
+
+	// An empty confirmation doesn't match the missing name, only the ID does
+	requests := append(lookups, lookups...)
+	requests = append(requests, taskFlow(t, "DELETE", "/subscriptions/1234", "", "delete-sub", "subscriptionDeleteRequest")...)
+	s := httptest.NewServer(testServer("apiKey", "secret", requests...))
+
+	subject, err := clientFromTestServer(s, "apiKey", "secret")
+	require.NoError(t, err)
+
+	_, err = subject.Subscription.DeleteCascade(context.TODO(), 1234, subscriptions.DeleteCascadeOptions{})
+	assert.ErrorIs(t, err, subscriptions.ErrNotConfirmed)
+	assert.EqualError(t, err, `subscription deletion was not confirmed: confirm with "1234"`)
+
+	plan, err := subject.Subscription.DeleteCascade(context.TODO(), 1234, subscriptions.DeleteCascadeOptions{Confirm: "1234"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"delete subscription 1234"}, plan.Steps)
+}
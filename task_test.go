@@ -8,6 +8,7 @@ import (
 
 	"github.com/RedisLabs/rediscloud-go-api/internal"
 	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/tasks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -103,3 +104,98 @@ func TestTask_Handles404Eventually(t *testing.T) {
 		Body:       []byte{},
 	}, actual)
 }
+
+func TestTask_Get(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret", getRequest(t, "/tasks/task", `{
+  "taskId": "task",
+  "commandType": "subscriptionCreateRequest",
+  "status": "processing-in-progress",
+  "description": "Request processing is in progress",
+  "timestamp": "2020-10-28T09:58:16.798Z",
+  "response": {}
+}`)))
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Tasks.Get(context.TODO(), "task")
+	require.NoError(t, err)
+
+	assert.Equal(t, &tasks.Task{
+		CommandType: redis.String("subscriptionCreateRequest"),
+		Description: redis.String("Request processing is in progress"),
+		Status:      redis.String("processing-in-progress"),
+		ID:          redis.String("task"),
+		Response:    &tasks.Response{},
+	}, actual)
+}
+
+func TestTask_GetNotFound(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret", getRequestWithStatus(t, "/tasks/task", 404, "")))
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	_, err = subject.Tasks.Get(context.TODO(), "task")
+	assert.EqualError(t, err, "task task not found")
+}
+
+func TestTask_Wait(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret", getRequest(t, "/tasks/task", `{
+  "taskId": "task",
+  "commandType": "subscriptionCreateRequest",
+  "status": "processing-in-progress",
+  "response": {}
+}`), getRequest(t, "/tasks/task", `{
+  "taskId": "task",
+  "commandType": "subscriptionCreateRequest",
+  "status": "processing-completed",
+  "response": {
+    "resourceId": 42
+  }
+}`)))
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Tasks.Wait(context.TODO(), "task")
+	require.NoError(t, err)
+
+	assert.Equal(t, &tasks.Task{
+		CommandType: redis.String("subscriptionCreateRequest"),
+		Status:      redis.String("processing-completed"),
+		ID:          redis.String("task"),
+		Response:    &tasks.Response{ID: redis.Int(42)},
+	}, actual)
+}
+
+func TestTask_WaitReturnsFailedTask(t *testing.T) {
+	s := httptest.NewServer(testServer("key", "secret", getRequest(t, "/tasks/task", `{
+  "taskId": "task",
+  "commandType": "subscriptionCreateRequest",
+  "status": "processing-error",
+  "response": {
+    "error": {
+      "type": "SUBSCRIPTION_PI_NOT_FOUND",
+      "status": "400 BAD_REQUEST",
+      "description": "Payment info was not found for subscription"
+    }
+  }
+}`)))
+
+	subject, err := clientFromTestServer(s, "key", "secret")
+	require.NoError(t, err)
+
+	actual, err := subject.Tasks.Wait(context.TODO(), "task")
+	assert.EqualError(t, err, "task task failed: 400 BAD_REQUEST - SUBSCRIPTION_PI_NOT_FOUND: Payment info was not found for subscription")
+	assert.Equal(t, &tasks.Task{
+		CommandType: redis.String("subscriptionCreateRequest"),
+		Status:      redis.String("processing-error"),
+		ID:          redis.String("task"),
+		Response: &tasks.Response{Error: &tasks.Error{
+			Type:        redis.String("SUBSCRIPTION_PI_NOT_FOUND"),
+			Description: redis.String("Payment info was not found for subscription"),
+			Status:      redis.String("400 BAD_REQUEST"),
+		}},
+	}, actual)
+}
@@ -13,7 +13,11 @@ const (
 	// SecretKeyEnvVar is the environment variable that will be used for the secret key by default.
 	SecretKeyEnvVar = "REDISCLOUD_SECRET_KEY"
 
+	// RedisCloudUrlEnvVar is the environment variable that will be used for the base URL of the API by default.
 	RedisCloudUrlEnvVar = "REDISCLOUD_URL"
+
+	// ProfileEnvVar is the environment variable naming the profile of the config file used by default.
+	ProfileEnvVar = "REDISCLOUD_PROFILE"
 )
 
 var userAgent = buildUserAgent("rediscloud-go-api", Version, runtime.Version(), runtime.GOOS, runtime.GOARCH)
@@ -548,6 +548,6 @@ func TestGetUser(t *testing.T) {
 		ID:     redis.Int(20000),
 		Name:   redis.String("test-user"),
 		Role:   redis.String("test-role"),
-		Status: redis.String(users.StatusPending),
+		Status: redis.Ptr(users.StatusPending),
 	}, actual)
 }
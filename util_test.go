@@ -15,7 +15,7 @@ import (
 )
 
 func clientFromTestServer(s *httptest.Server, apiKey string, secretKey string) (*Client, error) {
-	return NewClient(LogRequests(true), BaseURL(s.URL), Auth(apiKey, secretKey), Transporter(s.Client().Transport))
+	return NewClient(LogRequests(true), BaseURL(s.URL+"/v1"), Auth(apiKey, secretKey), Transporter(s.Client().Transport))
 }
 
 func testServer(apiKey, secretKey string, mockedResponses ...endpointRequest) http.HandlerFunc {
@@ -82,7 +82,7 @@ func (e endpointRequest) matches(r *http.Request) bool {
 	if !assert.Equal(e.t, e.method, r.Method) {
 		return false
 	}
-	if !assert.Equal(e.t, e.path, r.URL.Path) {
+	if !assert.Equal(e.t, "/v1"+e.path, r.URL.Path) {
 		return false
 	}
 	return true
@@ -165,6 +165,17 @@ func postRequest(t *testing.T, path string, request string, body string) endpoin
 	}
 }
 
+func postRequestWithStatus(t *testing.T, path string, request string, status int, body string) endpointRequest {
+	return endpointRequest{
+		method:      http.MethodPost,
+		path:        path,
+		body:        body,
+		requestBody: &request,
+		status:      status,
+		t:           t,
+	}
+}
+
 func postRequestWithNoRequest(t *testing.T, path string, body string) endpointRequest {
 	return endpointRequest{
 		method: http.MethodPost,
@@ -236,3 +247,29 @@ func taskFlow(t *testing.T, method, path, requestBody, taskID, commandType strin
 
 	return []endpointRequest{first, second}
 }
+
+// getTaskFlow returns the two endpointRequests needed for a "GET -> GET /tasks/{id}" flow, where the completed task
+// carries the given resource
+func getTaskFlow(t *testing.T, path, taskID, commandType, resource string) []endpointRequest {
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	first := getRequest(t, path, fmt.Sprintf(`{
+      "taskId": "%s",
+      "commandType": "%s",
+      "status": "received",
+      "description": "Task queued.",
+      "timestamp": "%s",
+      "_links": { "task": { "href": "https://example.org", "title": "getTaskStatusUpdates", "type": "GET" } }
+    }`, taskID, commandType, now))
+
+	second := getRequest(t, "/tasks/"+taskID, fmt.Sprintf(`{
+      "taskId": "%s",
+      "commandType": "%s",
+      "status": "processing-completed",
+      "timestamp": "%s",
+      "response": { "resource": %s },
+      "_links": { "self": { "href": "https://example.com", "type": "GET" } }
+    }`, taskID, commandType, now, resource))
+
+	return []endpointRequest{first, second}
+}